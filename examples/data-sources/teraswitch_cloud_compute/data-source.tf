@@ -0,0 +1,12 @@
+# Get details about an instance managed elsewhere
+data "teraswitch_cloud_compute" "bastion" {
+  id = 12345
+}
+
+output "bastion_ips" {
+  value = data.teraswitch_cloud_compute.bastion.ip_addresses
+}
+
+output "bastion_spec" {
+  value = "${data.teraswitch_cloud_compute.bastion.vcpus} vCPU / ${data.teraswitch_cloud_compute.bastion.memory} GB"
+}
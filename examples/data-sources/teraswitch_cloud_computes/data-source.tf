@@ -0,0 +1,13 @@
+# Get all instances in the project
+data "teraswitch_cloud_computes" "all" {}
+
+# Get active instances in a region with a tag
+data "teraswitch_cloud_computes" "web" {
+  status = "Active"
+  region = "PIT1"
+  tag    = "web"
+}
+
+output "web_ips" {
+  value = { for instance in data.teraswitch_cloud_computes.web.instances : instance.display_name => instance.ip_addresses }
+}
@@ -0,0 +1,20 @@
+# List all cloud tiers
+data "teraswitch_cloud_tiers" "all" {}
+
+output "visible_tier_ids" {
+  value = [for tier in data.teraswitch_cloud_tiers.all.tiers : tier.id if !tier.hidden]
+}
+
+# Select the smallest tier with at least 2 vCPUs and 4 GB of memory
+data "teraswitch_cloud_tiers" "app" {
+  min_vcpus  = 2
+  min_memory = 4
+}
+
+resource "teraswitch_cloud_compute" "app" {
+  region_id    = "PIT1"
+  tier_id      = data.teraswitch_cloud_tiers.app.id
+  image_id     = "ubuntu-noble"
+  display_name = "app-server"
+  boot_size    = 64
+}
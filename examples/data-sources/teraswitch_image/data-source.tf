@@ -0,0 +1,24 @@
+# Select the newest Ubuntu image
+data "teraswitch_image" "ubuntu" {
+  operating_system_name = "Ubuntu"
+  version               = "latest"
+}
+
+# Select the newest Ubuntu 22.x release
+data "teraswitch_image" "ubuntu_22" {
+  operating_system_name = "Ubuntu"
+  version               = "~> 22.0"
+}
+
+# Look up a known image by ID
+data "teraswitch_image" "noble" {
+  id = "ubuntu-noble"
+}
+
+resource "teraswitch_cloud_compute" "app" {
+  region_id    = "PIT1"
+  tier_id      = "s1.1c1g"
+  image_id     = data.teraswitch_image.ubuntu.id
+  display_name = "app-server"
+  boot_size    = 64
+}
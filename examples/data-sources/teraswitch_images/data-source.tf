@@ -0,0 +1,16 @@
+# List all available images
+data "teraswitch_images" "all" {}
+
+# List Ubuntu images only
+data "teraswitch_images" "ubuntu" {
+  operating_system_name = "Ubuntu"
+}
+
+output "ubuntu_versions" {
+  value = { for image in data.teraswitch_images.ubuntu.images : image.id => image.operating_system_version }
+}
+
+# Images that allow custom partitions and RAID on metal services
+output "customizable_images" {
+  value = [for image in data.teraswitch_images.all.images : image.id if image.supports_custom_partitions]
+}
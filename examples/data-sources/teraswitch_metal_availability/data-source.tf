@@ -0,0 +1,18 @@
+data "teraswitch_metal_availability" "all" {
+  project_id = 480
+}
+
+# Regions where the 7950x tier is in stock
+output "regions_with_7950x" {
+  value = [for region, tiers in data.teraswitch_metal_availability.all.availability : region if lookup(tiers, "7950x", 0) > 0]
+}
+
+# Tiers in stock in a single region
+data "teraswitch_metal_availability" "slc1" {
+  project_id = 480
+  region     = "SLC1"
+}
+
+output "slc1_in_stock" {
+  value = [for tier, quantity in data.teraswitch_metal_availability.slc1.availability["SLC1"] : tier if quantity > 0]
+}
@@ -0,0 +1,24 @@
+# The last 50 cloud-init log lines of a metal server
+data "teraswitch_metal_logs" "cloud_init" {
+  metal_id = teraswitch_metal.my-dedi.id
+  name     = "cloud-init"
+  tail     = 50
+}
+
+output "cloud_init_logs" {
+  value = [for line in data.teraswitch_metal_logs.cloud_init.logs : "[${line.timestamp}] ${line.message}"]
+}
+
+# Warn when cloud-init reported errors after the server was built
+check "cloud_init_clean" {
+  data "teraswitch_metal_logs" "errors" {
+    metal_id = teraswitch_metal.my-dedi.id
+    name     = "cloud-init"
+    since    = "2025-01-30T00:00:00Z"
+  }
+
+  assert {
+    condition     = length([for line in data.teraswitch_metal_logs.errors.logs : line if strcontains(lower(line.message), "error")]) == 0
+    error_message = "cloud-init logged errors on the metal server"
+  }
+}
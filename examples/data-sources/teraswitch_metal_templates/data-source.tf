@@ -0,0 +1,14 @@
+# List the metal templates available to the project
+data "teraswitch_metal_templates" "all" {}
+
+output "metal_templates" {
+  value = { for template in data.teraswitch_metal_templates.all.templates : template.display_name => template.id }
+}
+
+# Deploy a server from a template; the template's tier, image and storage
+# layout show up in the plan
+resource "teraswitch_metal" "from_template" {
+  region_id    = "SLC1"
+  display_name = "from-template"
+  template_id  = data.teraswitch_metal_templates.all.templates[0].id
+}
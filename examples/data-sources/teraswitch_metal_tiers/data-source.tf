@@ -14,3 +14,24 @@ output "tier_ids" {
 output "amd_epyc_tiers" {
   value = [for tier in data.teraswitch_metal_tiers.all.tiers : tier if can(regex("EPYC", tier.cpu))]
 }
+
+# Find tiers in stock in SLC1 with at least 128 GB of memory under $500/month
+data "teraswitch_metal_tiers" "slc1" {
+  region            = "SLC1"
+  min_memory_gb     = 128
+  max_monthly_price = 500
+}
+
+locals {
+  tier = data.teraswitch_metal_tiers.slc1.tiers[0]
+}
+
+# Build a valid disks map and memory size from the tier's options
+resource "teraswitch_metal" "server" {
+  region_id   = "SLC1"
+  tier_id     = local.tier.id
+  image_id    = "ubuntu-noble"
+  ssh_key_ids = [588]
+  memory_gb   = min([for option in local.tier.memory_options : option.gb if option.gb >= 128]...)
+  disks       = local.tier.default_disks
+}
@@ -0,0 +1,14 @@
+# Get all metal services in the project
+data "teraswitch_metals" "all" {}
+
+# Get active metal services in a region with a tag
+data "teraswitch_metals" "web" {
+  status = "Active"
+  region = "PIT1"
+  tag    = "web"
+}
+
+# Map server names to their IP addresses, e.g. for DNS records
+output "web_ips" {
+  value = { for metal in data.teraswitch_metals.web.metals : metal.display_name => metal.ip_addresses }
+}
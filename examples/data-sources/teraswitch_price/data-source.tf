@@ -0,0 +1,26 @@
+# Price a metal server with upgraded memory and drives
+data "teraswitch_price" "metal" {
+  region_id = "SLC1"
+  tier_id   = "7950x"
+  memory_gb = 128
+  disks = {
+    "nvme0n1" : "1.92t",
+    "nvme1n1" : "1.92t",
+  }
+  reserved = false
+}
+
+output "metal_monthly_price" {
+  value = data.teraswitch_price.metal.monthly_price
+}
+
+output "metal_drive_prices" {
+  value = { for drive in data.teraswitch_price.metal.drives : drive.slot => drive.monthly_price }
+}
+
+# Price a cloud compute instance
+data "teraswitch_price" "instance" {
+  service_type = "Instance"
+  region_id    = "PIT1"
+  tier_id      = "s1.1c1g"
+}
@@ -1,4 +1,12 @@
 provider "teraswitch" {
   api_key    = "your-api-key"
   project_id = 123
+
+  # Optional: tune retries of failed API requests
+  max_retries    = 4
+  retry_max_wait = "30s"
+
+  # Optional: fail plans whose new servers would cost more than this per month
+  max_monthly_spend     = 5000
+  include_current_spend = true
 }
@@ -0,0 +1,2 @@
+# Cloud compute instances can be imported using the instance ID:
+terraform import teraswitch_cloud_compute.example 12345
@@ -0,0 +1,2 @@
+# Instance network attachments can be imported using the instance ID and network ID:
+terraform import teraswitch_instance_network_attachment.example 12345/0b6e2b8e-5b0a-4b5e-9d8e-2f1c3a4b5c6d
@@ -0,0 +1,19 @@
+resource "teraswitch_network" "private" {
+  region_id      = "PIT1"
+  display_name   = "private"
+  v4_subnet      = "10.99.0.0"
+  v4_subnet_mask = "24"
+}
+
+resource "teraswitch_cloud_compute" "my-vm" {
+  region_id    = "PIT1"
+  tier_id      = "s1.1c1g"
+  image_id     = "ubuntu-noble"
+  display_name = "terraform-vm"
+  boot_size    = 64
+}
+
+resource "teraswitch_instance_network_attachment" "private" {
+  instance_id = teraswitch_cloud_compute.my-vm.id
+  network_id  = teraswitch_network.private.id
+}
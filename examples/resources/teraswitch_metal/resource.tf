@@ -25,4 +25,12 @@ resource "teraswitch_metal" "my-dedi" {
   reserve_pricing     = false
   desired_power_state = null
   wait_for_ready      = true
+
+  # Reinstall in place instead of replacing the server when the image,
+  # access or storage layout changes
+  reinstall_on_change = true
+
+  timeouts {
+    create = "60m"
+  }
 }
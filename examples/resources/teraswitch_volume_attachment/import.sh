@@ -0,0 +1,2 @@
+# Volume attachments can be imported using the volume ID and instance ID:
+terraform import teraswitch_volume_attachment.example 0b6e2b8e-5b0a-4b5e-9d8e-2f1c3a4b5c6d/12345
@@ -0,0 +1,20 @@
+resource "teraswitch_volume" "data" {
+  region_id    = "PIT1"
+  display_name = "data"
+  size         = 20
+  volume_type  = "nvme"
+}
+
+resource "teraswitch_cloud_compute" "my-vm" {
+  region_id    = "PIT1"
+  tier_id      = "s1.1c1g"
+  image_id     = "ubuntu-noble"
+  display_name = "terraform-vm"
+  boot_size    = 64
+}
+
+resource "teraswitch_volume_attachment" "data" {
+  volume_id   = teraswitch_volume.data.id
+  instance_id = teraswitch_cloud_compute.my-vm.id
+  mount_point = "/mnt/data"
+}
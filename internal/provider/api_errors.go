@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// apiError is a failed response from the TeraSwitch API, decoded from one of
+// the API's error envelopes.
+type apiError struct {
+	StatusCode int
+	Message    string
+
+	// Fields holds validation failures keyed by the API's field name, e.g.
+	// "TierId".
+	Fields map[string][]string
+}
+
+// validationProblem is the body the API returns when request validation
+// fails.
+type validationProblem struct {
+	Title  *string             `json:"title"`
+	Detail *string             `json:"detail"`
+	Errors map[string][]string `json:"errors"`
+}
+
+// newAPIError decodes a failed API response.
+func newAPIError(statusCode int, body []byte) *apiError {
+	e := &apiError{StatusCode: statusCode}
+
+	var message *string
+	switch {
+	case statusCode == http.StatusNotFound:
+		var res client.NotFoundErrorResponse
+		if json.Unmarshal(body, &res) == nil {
+			message = res.Message
+		}
+	case statusCode >= http.StatusInternalServerError:
+		var res client.InternalErrorResponse
+		if json.Unmarshal(body, &res) == nil {
+			message = res.Message
+		}
+	default:
+		var res client.ApiResponse
+		if json.Unmarshal(body, &res) == nil {
+			message = res.Message
+		}
+	}
+
+	var problem validationProblem
+	if json.Unmarshal(body, &problem) == nil {
+		e.Fields = problem.Errors
+		if message == nil {
+			message = problem.Detail
+		}
+		if message == nil {
+			message = problem.Title
+		}
+	}
+
+	switch {
+	case message != nil && *message != "":
+		e.Message = *message
+	case len(strings.TrimSpace(string(body))) > 0 && !json.Valid(body):
+		// Not one of the API's envelopes, e.g. an HTML page from a proxy
+		e.Message = truncate(strings.TrimSpace(string(body)), 500)
+	default:
+		e.Message = http.StatusText(statusCode)
+	}
+
+	return e
+}
+
+func (e *apiError) Error() string {
+	msg := fmt.Sprintf("API returned status %d: %s", e.StatusCode, e.Message)
+	for _, field := range sortedKeys(e.Fields) {
+		msg += fmt.Sprintf("; %s: %s", field, strings.Join(e.Fields[field], " "))
+	}
+	return msg
+}
+
+// apiErrorDiagnostics describes a failed API response. action completes the
+// sentence "Unable to ...", e.g. "create metal service". Validation failures
+// are attached to the matching attribute of data, the plan, state or config
+// that the request was built from.
+func apiErrorDiagnostics(action string, statusCode int, body []byte, data tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	e := newAPIError(statusCode, body)
+
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		diags.AddError("Invalid TeraSwitch Credentials",
+			fmt.Sprintf("Unable to %s: %s\n\nThe API key was rejected. Check the provider `api_key` argument "+
+				"or the TERASWITCH_API_KEY environment variable.", action, e.Message),
+		)
+		return diags
+	case http.StatusForbidden:
+		diags.AddError("TeraSwitch Permission Denied",
+			fmt.Sprintf("Unable to %s: %s\n\nThe API key isn't allowed to perform this operation. Check that it "+
+				"has access to the project set by `project_id` or the TERASWITCH_PROJECT_ID environment variable.", action, e.Message),
+		)
+		return diags
+	case http.StatusPaymentRequired:
+		diags.AddError("TeraSwitch Billing Issue",
+			fmt.Sprintf("Unable to %s: %s\n\nThe account has a billing issue, such as a missing payment method "+
+				"or an unpaid invoice. Resolve it in the TeraSwitch portal and try again.", action, e.Message),
+		)
+		return diags
+	case http.StatusConflict:
+		diags.AddError("TeraSwitch Resource Conflict",
+			fmt.Sprintf("Unable to %s: %s\n\nThe request conflicts with the current state of the resource, which "+
+				"may be busy with another operation. Wait for it to finish and try again.", action, e.Message),
+		)
+		return diags
+	}
+
+	if e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity {
+		var unmatched []string
+		for _, field := range sortedKeys(e.Fields) {
+			detail := fmt.Sprintf("Unable to %s: %s", action, strings.Join(e.Fields[field], " "))
+			if p, ok := apiFieldPath(data, field); ok {
+				diags.AddAttributeError(p, "Invalid Attribute Value", detail)
+			} else {
+				unmatched = append(unmatched, fmt.Sprintf("%s: %s", field, strings.Join(e.Fields[field], " ")))
+			}
+		}
+
+		if len(e.Fields) == 0 || len(unmatched) > 0 {
+			detail := fmt.Sprintf("Unable to %s: %s", action, e.Message)
+			if len(unmatched) > 0 {
+				detail += "\n\n" + strings.Join(unmatched, "\n")
+			}
+			diags.AddError("Invalid Request", detail)
+		}
+		return diags
+	}
+
+	summary := "Client Error"
+	if e.StatusCode >= http.StatusInternalServerError {
+		summary = "TeraSwitch API Error"
+	}
+	diags.AddError(summary, fmt.Sprintf("Unable to %s, got status %d: %s", action, e.StatusCode, e.Message))
+
+	return diags
+}
+
+// apiFieldPath maps an API field name such as "TierId", "$.tierId" or
+// "Partitions[0].MountPoint" to the path of the matching attribute in data,
+// the plan, state or config that the request was built from. It reports false
+// if data has no such attribute.
+func apiFieldPath(data tftypes.Value, field string) (path.Path, bool) {
+	if data.Type() == nil {
+		return path.Empty(), false
+	}
+
+	field = strings.TrimPrefix(strings.TrimPrefix(field, "$"), ".")
+
+	p := path.Empty()
+	steps := tftypes.NewAttributePath()
+	for _, segment := range strings.Split(field, ".") {
+		name, indexes, ok := parseAPIFieldSegment(segment)
+		if !ok {
+			return path.Empty(), false
+		}
+
+		name = snakeCase(name)
+		p = p.AtName(name)
+		steps = steps.WithAttributeName(name)
+		for _, i := range indexes {
+			p = p.AtListIndex(i)
+			steps = steps.WithElementKeyInt(i)
+		}
+	}
+
+	if _, _, err := tftypes.WalkAttributePath(data, steps); err != nil {
+		return path.Empty(), false
+	}
+	return p, true
+}
+
+// parseAPIFieldSegment splits a field name segment like "Partitions[0]" into
+// its name and list indexes.
+func parseAPIFieldSegment(segment string) (string, []int, bool) {
+	i := strings.IndexByte(segment, '[')
+	if i < 0 {
+		i = len(segment)
+	}
+	name, rest := segment[:i], segment[i:]
+	if name == "" {
+		return "", nil, false
+	}
+
+	var indexes []int
+	for rest != "" {
+		index, after, ok := strings.Cut(strings.TrimPrefix(rest, "["), "]")
+		if !ok || !strings.HasPrefix(rest, "[") {
+			return "", nil, false
+		}
+
+		i, err := strconv.Atoi(index)
+		if err != nil || i < 0 {
+			return "", nil, false
+		}
+		indexes = append(indexes, i)
+		rest = after
+	}
+
+	return name, indexes, true
+}
+
+// snakeCase converts a field name like "SshKeyIds" or "mountPoint" to the
+// attribute naming convention.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// testAPIErrorData is a plan with a root and a nested attribute, shaped like
+// the metal resource's.
+func testAPIErrorData() tftypes.Value {
+	partitionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"mount_point": tftypes.String,
+	}}
+	dataType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"tier_id":     tftypes.String,
+		"ssh_key_ids": tftypes.List{ElementType: tftypes.Number},
+		"partitions":  tftypes.List{ElementType: partitionType},
+	}}
+
+	return tftypes.NewValue(dataType, map[string]tftypes.Value{
+		"tier_id":     tftypes.NewValue(tftypes.String, "7950x"),
+		"ssh_key_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+		"partitions": tftypes.NewValue(tftypes.List{ElementType: partitionType}, []tftypes.Value{
+			tftypes.NewValue(partitionType, map[string]tftypes.Value{
+				"mount_point": tftypes.NewValue(tftypes.String, "/"),
+			}),
+		}),
+	})
+}
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantSummary string
+		wantPath    *path.Path
+	}{
+		{"unauthorized", http.StatusUnauthorized, `{"message":"bad key","success":false}`, "Invalid TeraSwitch Credentials", nil},
+		{"forbidden", http.StatusForbidden, `{"message":"nope","success":false}`, "TeraSwitch Permission Denied", nil},
+		{"payment required", http.StatusPaymentRequired, `{"message":"card declined","success":false}`, "TeraSwitch Billing Issue", nil},
+		{"conflict", http.StatusConflict, `{"message":"busy","success":false}`, "TeraSwitch Resource Conflict", nil},
+		{"internal error", http.StatusInternalServerError, `{"message":"boom","success":false}`, "TeraSwitch API Error", nil},
+		{"plain bad request", http.StatusBadRequest, `{"message":"invalid","success":false}`, "Invalid Request", nil},
+		{"field validation", http.StatusBadRequest, `{"title":"One or more validation errors occurred.","errors":{"TierId":["Unknown tier."]}}`, "Invalid Attribute Value", PtrTo(path.Root("tier_id"))},
+		{"nested field validation", http.StatusBadRequest, `{"title":"One or more validation errors occurred.","errors":{"Partitions[0].MountPoint":["Invalid mount point."]}}`, "Invalid Attribute Value", PtrTo(path.Root("partitions").AtListIndex(0).AtName("mount_point"))},
+		{"unknown field validation", http.StatusBadRequest, `{"title":"One or more validation errors occurred.","errors":{"Size":["Too small."]}}`, "Invalid Request", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := apiErrorDiagnostics("create metal service", tt.status, []byte(tt.body), testAPIErrorData())
+			require.Len(t, diags, 1)
+			require.Equal(t, tt.wantSummary, diags[0].Summary())
+
+			withPath, ok := diags[0].(interface{ Path() path.Path })
+			if tt.wantPath == nil {
+				require.False(t, ok)
+			} else {
+				require.True(t, ok)
+				require.True(t, withPath.Path().Equal(*tt.wantPath))
+			}
+		})
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	e := newAPIError(http.StatusNotFound, []byte(`{"message":"Metal service 1 not found","success":false}`))
+	require.Equal(t, "Metal service 1 not found", e.Message)
+
+	e = newAPIError(http.StatusBadGateway, []byte(`<html>bad gateway</html>`))
+	require.Equal(t, "<html>bad gateway</html>", e.Message)
+
+	e = newAPIError(http.StatusServiceUnavailable, nil)
+	require.Equal(t, "Service Unavailable", e.Message)
+
+}
+
+func TestAPIFieldPath(t *testing.T) {
+	data := testAPIErrorData()
+
+	tests := []struct {
+		field string
+		want  *path.Path
+	}{
+		{"TierId", PtrTo(path.Root("tier_id"))},
+		{"$.tierId", PtrTo(path.Root("tier_id"))},
+		{"SshKeyIds", PtrTo(path.Root("ssh_key_ids"))},
+		{"Partitions[0].MountPoint", PtrTo(path.Root("partitions").AtListIndex(0).AtName("mount_point"))},
+		{"$.partitions[0].mountPoint", PtrTo(path.Root("partitions").AtListIndex(0).AtName("mount_point"))},
+		// Not attributes of this data
+		{"MountPoint", nil},
+		{"Size", nil},
+		{"Partitions[1].MountPoint", nil},
+		{"SshKeyIds[0]", nil},
+		{"Partitions[x]", nil},
+		{"[0]", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			got, ok := apiFieldPath(data, tt.field)
+			if tt.want == nil {
+				require.False(t, ok, "got %s", got)
+			} else {
+				require.True(t, ok)
+				require.True(t, got.Equal(*tt.want), "got %s", got)
+			}
+		})
+	}
+
+	_, ok := apiFieldPath(tftypes.Value{}, "TierId")
+	require.False(t, ok)
+}
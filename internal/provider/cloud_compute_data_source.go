@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudComputeDataSource{}
+
+func NewCloudComputeDataSource() datasource.DataSource {
+	return &CloudComputeDataSource{}
+}
+
+// CloudComputeDataSource defines the data source implementation.
+type CloudComputeDataSource struct {
+	providerData *ProviderData
+}
+
+// CloudComputeDataSourceModel describes the data source data model. It is
+// shared with the entries of the teraswitch_cloud_computes data source.
+type CloudComputeDataSourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	RegionID    types.String `tfsdk:"region_id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	TierID      types.String `tfsdk:"tier_id"`
+	Vcpus       types.Int64  `tfsdk:"vcpus"`
+	Memory      types.Int64  `tfsdk:"memory"`
+	Transfer    types.Int64  `tfsdk:"transfer"`
+	ImageID     types.String `tfsdk:"image_id"`
+	ImageName   types.String `tfsdk:"image_name"`
+	Status      types.String `tfsdk:"status"`
+	PowerState  types.String `tfsdk:"power_state"`
+	IPAddresses types.List   `tfsdk:"ip_addresses"`
+	Tags        types.List   `tfsdk:"tags"`
+	Created     types.String `tfsdk:"created"`
+}
+
+func (d *CloudComputeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_compute"
+}
+
+// cloudComputeAttributes returns the computed attributes describing a cloud
+// compute instance.
+func cloudComputeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"project_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the project that the instance belongs to.",
+			Computed:            true,
+		},
+		"region_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the region where the instance is located.",
+			Computed:            true,
+		},
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "The display name of the instance.",
+			Computed:            true,
+		},
+		"tier_id": schema.StringAttribute{
+			MarkdownDescription: "The service tier of the instance.",
+			Computed:            true,
+		},
+		"vcpus": schema.Int64Attribute{
+			MarkdownDescription: "The number of virtual CPUs of the instance's tier.",
+			Computed:            true,
+		},
+		"memory": schema.Int64Attribute{
+			MarkdownDescription: "The amount of memory of the instance's tier.",
+			Computed:            true,
+		},
+		"transfer": schema.Int64Attribute{
+			MarkdownDescription: "The network transfer allowance of the instance's tier.",
+			Computed:            true,
+		},
+		"image_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the OS image used to create the instance.",
+			Computed:            true,
+		},
+		"image_name": schema.StringAttribute{
+			MarkdownDescription: "The display name of the OS image used to create the instance.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The current status of the instance.",
+			Computed:            true,
+		},
+		"power_state": schema.StringAttribute{
+			MarkdownDescription: "The power state of the instance.",
+			Computed:            true,
+		},
+		"ip_addresses": schema.ListAttribute{
+			MarkdownDescription: "The IP addresses assigned to the instance.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "Tags associated with the instance.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"created": schema.StringAttribute{
+			MarkdownDescription: "The date when the instance was created.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *CloudComputeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := cloudComputeAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The ID of the instance to retrieve.",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cloud compute data source allows you to retrieve information about a specific cloud compute instance.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *CloudComputeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *CloudComputeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudComputeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.providerData.client.GetV2InstanceIdWithResponse(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instance, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("read instance", res.StatusCode(), res.Body, req.Config.Raw)...)
+		return
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		resp.Diagnostics.AddError("Client Error", "Instance not found")
+		return
+	}
+
+	data, diags := newCloudComputeDataSourceModel(ctx, *res.JSON200.Result)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "read cloud compute data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newCloudComputeDataSourceModel maps an API cloud service to the data
+// source model.
+func newCloudComputeDataSourceModel(ctx context.Context, instance client.CloudService) (CloudComputeDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	m := CloudComputeDataSourceModel{
+		ID:          types.Int64PointerValue(instance.Id),
+		ProjectID:   types.Int64PointerValue(instance.ProjectId),
+		RegionID:    types.StringPointerValue(instance.RegionId),
+		DisplayName: types.StringPointerValue(instance.DisplayName),
+		TierID:      types.StringPointerValue(instance.TierId),
+		Vcpus:       types.Int64Null(),
+		Memory:      types.Int64Null(),
+		Transfer:    types.Int64Null(),
+		ImageID:     types.StringPointerValue(instance.ImageId),
+		ImageName:   types.StringNull(),
+		Status:      types.StringPointerValue(instance.Status),
+		PowerState:  types.StringNull(),
+		IPAddresses: types.ListNull(types.StringType),
+		Tags:        types.ListNull(types.StringType),
+		Created:     types.StringPointerValue(instance.Created),
+	}
+
+	if tier := instance.Tier; tier != nil {
+		if tier.Vcpus != nil {
+			m.Vcpus = types.Int64Value(int64(*tier.Vcpus))
+		}
+		if tier.Memory != nil {
+			m.Memory = types.Int64Value(int64(*tier.Memory))
+		}
+		if tier.Transfer != nil {
+			m.Transfer = types.Int64Value(int64(*tier.Transfer))
+		}
+	}
+
+	if instance.Image != nil {
+		m.ImageName = types.StringPointerValue(instance.Image.DisplayName)
+	}
+
+	if instance.PowerState != nil {
+		m.PowerState = types.StringValue(string(*instance.PowerState))
+	}
+
+	if instance.IpAddresses != nil {
+		ipList, d := types.ListValueFrom(ctx, types.StringType, *instance.IpAddresses)
+		diags.Append(d...)
+		m.IPAddresses = ipList
+	}
+
+	if instance.Tags != nil {
+		tagsList, d := types.ListValueFrom(ctx, types.StringType, *instance.Tags)
+		diags.Append(d...)
+		m.Tags = tagsList
+	}
+
+	return m, diags
+}
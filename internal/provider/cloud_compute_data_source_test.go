@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudComputeDataSource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudComputeDataSourceConfig("yeehaw"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.teraswitch_cloud_compute.test", "id", "teraswitch_cloud_compute.test", "id"),
+					resource.TestCheckResourceAttr("data.teraswitch_cloud_compute.test", "display_name", "yeehaw"),
+					resource.TestCheckResourceAttr("data.teraswitch_cloud_compute.test", "tier_id", "s1.1c1g"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_cloud_compute.test", "vcpus"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_cloud_compute.test", "memory"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_cloud_computes.test", "instances.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudComputeDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "teraswitch" {}
+
+resource "teraswitch_cloud_compute" "test" {
+  region_id    = "PIT1"
+  tier_id      = "s1.1c1g"
+  image_id     = "ubuntu-noble"
+  display_name = %[1]q
+  boot_size    = 64
+  ssh_key_ids  = [588]
+}
+
+data "teraswitch_cloud_compute" "test" {
+  id = teraswitch_cloud_compute.test.id
+}
+
+data "teraswitch_cloud_computes" "test" {
+  region = "PIT1"
+
+  depends_on = [teraswitch_cloud_compute.test]
+}
+`, name)
+}
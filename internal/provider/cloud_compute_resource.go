@@ -4,16 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,11 +31,19 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CloudComputeResource{}
 var _ resource.ResourceWithImportState = &CloudComputeResource{}
+var _ resource.ResourceWithModifyPlan = &CloudComputeResource{}
 
 func NewCloudComputeResource() resource.Resource {
 	return &CloudComputeResource{}
 }
 
+// cloudComputeTimeouts are the default deadlines of the resource's operations.
+var cloudComputeTimeouts = resourceTimeouts{
+	Create: 15 * time.Minute,
+	Update: 15 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
 // CloudComputeResource defines the resource implementation.
 type CloudComputeResource struct {
 	providerData *ProviderData
@@ -39,20 +51,23 @@ type CloudComputeResource struct {
 
 // CloudComputeResourceModel describes the resource data model.
 type CloudComputeResourceModel struct {
-	ID                types.Int64  `tfsdk:"id"`
-	ProjectID         types.Int64  `tfsdk:"project_id"`
-	RegionID          types.String `tfsdk:"region_id"`
-	TierID            types.String `tfsdk:"tier_id"`
-	ImageID           types.String `tfsdk:"image_id"`
-	DisplayName       types.String `tfsdk:"display_name"`
-	SSHKeyIDs         types.List   `tfsdk:"ssh_key_ids"`
-	Password          types.String `tfsdk:"password"`
-	BootSize          types.Int64  `tfsdk:"boot_size"`
-	UserData          types.String `tfsdk:"user_data"`
-	Tags              types.List   `tfsdk:"tags"`
-	IPAddresses       types.List   `tfsdk:"ip_addresses"`
-	DesiredPowerState types.String `tfsdk:"desired_power_state"`
-	SkipWaitForReady  types.Bool   `tfsdk:"skip_wait_for_ready"`
+	ID                types.Int64    `tfsdk:"id"`
+	ProjectID         types.Int64    `tfsdk:"project_id"`
+	RegionID          types.String   `tfsdk:"region_id"`
+	TierID            types.String   `tfsdk:"tier_id"`
+	ImageID           types.String   `tfsdk:"image_id"`
+	DisplayName       types.String   `tfsdk:"display_name"`
+	SSHKeyIDs         types.List     `tfsdk:"ssh_key_ids"`
+	Password          types.String   `tfsdk:"password"`
+	BootSize          types.Int64    `tfsdk:"boot_size"`
+	UserData          types.String   `tfsdk:"user_data"`
+	Tags              types.List     `tfsdk:"tags"`
+	IPAddresses       types.List     `tfsdk:"ip_addresses"`
+	DesiredPowerState types.String   `tfsdk:"desired_power_state"`
+	SkipWaitForReady  types.Bool     `tfsdk:"skip_wait_for_ready"`
+	EstimatedHourly   types.Float64  `tfsdk:"estimated_hourly_price"`
+	EstimatedMonthly  types.Float64  `tfsdk:"estimated_monthly_price"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *CloudComputeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -101,15 +116,18 @@ func (r *CloudComputeResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"display_name": schema.StringAttribute{
-				MarkdownDescription: "The display name of the instance.",
+				MarkdownDescription: "The display name of the instance. The API can't rename instances, so changing it replaces the instance.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"ssh_key_ids": schema.ListAttribute{
 				MarkdownDescription: "The SSH key ids to be added to the service. These keys will be added to the authorized_keys file for the root user.",
 				Optional:            true,
 				ElementType:         types.Int64Type,
 				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImportedList("Changing the SSH keys of a tracked instance requires replacement."),
 				},
 				Validators: []validator.List{
 					listvalidator.AtLeastOneOf(path.MatchRoot("password")),
@@ -119,24 +137,24 @@ func (r *CloudComputeResource) Schema(ctx context.Context, req resource.SchemaRe
 				MarkdownDescription: "The password to be set for the root user. If not provided, a random password will be generated.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImportedString("Changing the password of a tracked instance requires replacement."),
 				},
 				Validators: []validator.String{
 					stringvalidator.AtLeastOneOf(path.MatchRoot("ssh_key_ids")),
 				},
 			},
 			"boot_size": schema.Int64Attribute{
-				MarkdownDescription: "The size of the boot disk.",
+				MarkdownDescription: "The size of the boot disk. The API doesn't report this value, so imported instances adopt the configured size without being replaced.",
 				Required:            true,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplaceIfConfigured(),
+					requiresReplaceUnlessImportedInt64("Changing the boot size of a tracked instance requires replacement."),
 				},
 			},
 			"user_data": schema.StringAttribute{
 				MarkdownDescription: "Additional user data.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImportedString("Changing the user data of a tracked instance requires replacement."),
 				},
 			},
 			"tags": schema.ListAttribute{
@@ -168,6 +186,24 @@ func (r *CloudComputeResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"estimated_hourly_price": schema.Float64Attribute{
+				MarkdownDescription: "The estimated hourly price of the configured tier, calculated when the instance is planned. Null if the price could not be calculated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"estimated_monthly_price": schema.Float64Attribute{
+				MarkdownDescription: "The estimated monthly price of the configured tier, calculated when the instance is planned. Null if the price could not be calculated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, cloudComputeTimeouts),
 		},
 	}
 }
@@ -191,6 +227,48 @@ func (r *CloudComputeResource) Configure(ctx context.Context, req resource.Confi
 	r.providerData = client
 }
 
+func (r *CloudComputeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to estimate on destroy
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	_, diags := planResumeProvisioning(ctx, req, resp)
+	resp.Diagnostics.Append(diags...)
+
+	if req.State.Raw.IsNull() || planChanged(req, "region_id", "tier_id") {
+		resp.Diagnostics.Append(r.estimatePrice(ctx, req, resp)...)
+	}
+
+	resp.Diagnostics.Append(checkPlannedSpend(ctx, r.providerData.spendGuard, "teraswitch_cloud_compute", req, resp)...)
+}
+
+// estimatePrice fills in the planned price estimates.
+func (r *CloudComputeResource) estimatePrice(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var regionID, tierID types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("region_id"), &regionID)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("tier_id"), &tierID)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var body *client.CalculatePriceRequest
+	if !regionID.IsUnknown() && !tierID.IsUnknown() {
+		body = &client.CalculatePriceRequest{
+			ServiceType: PtrTo(serviceTypeInstance),
+			RegionId:    regionID.ValueStringPointer(),
+			TierId:      tierID.ValueStringPointer(),
+		}
+	}
+
+	_, d := planPriceEstimate(ctx, r.providerData.client, &resp.Plan, body)
+	diags.Append(d...)
+
+	return diags
+}
+
 func (r *CloudComputeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data CloudComputeResourceModel
 
@@ -201,6 +279,15 @@ func (r *CloudComputeResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, cloudComputeTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	body := client.PostV2InstanceJSONRequestBody{
 		ProjectId:   data.ProjectID.ValueInt64Pointer(),
 		RegionId:    data.RegionID.ValueString(),
@@ -230,9 +317,7 @@ func (r *CloudComputeResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create get v2 instance, got error: %s", string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("create instance", res.StatusCode(), res.Body, req.Plan.Raw)...)
 		return
 	}
 
@@ -241,13 +326,33 @@ func (r *CloudComputeResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 	resBody := res.JSON200.Result
-	data.ID = types.Int64PointerValue(resBody.Id)
+	if resBody.Id == nil {
+		resp.Diagnostics.AddError("Client Error",
+			"Unable to create instance: the API accepted the request but returned no instance ID, so the instance can't be tracked. "+
+				"Check the TeraSwitch console for a new instance before applying again.")
+		return
+	}
+	data.ID = types.Int64Value(*resBody.Id)
+	resolvePriceEstimate(&data.EstimatedHourly, &data.EstimatedMonthly)
 
 	if !data.SkipWaitForReady.ValueBool() {
+		// Track the instance before waiting so it isn't leaked if waiting
+		// fails. Terraform taints it because of the error, so the next apply
+		// replaces it.
+		data.IPAddresses = types.ListValueMust(types.StringType, []attr.Value{})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(markProvisioningIncomplete(ctx, resp.Private)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		final, err := r.waitInstanceStatus(ctx, *resBody.Id, "Active")
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error",
-				fmt.Sprintf("Unable to wait v2 instance ready, got error: %s", err),
+				fmt.Sprintf("Unable to wait v2 instance ready, got error: %s\n\n"+
+					"Instance %d was created and has been saved to state as tainted, so the next apply will replace it. "+
+					"To keep the instance instead, run `terraform untaint` and the next apply will resume waiting for it.",
+					err, *resBody.Id),
 			)
 			return
 		}
@@ -261,6 +366,8 @@ func (r *CloudComputeResource) Create(ctx context.Context, req resource.CreateRe
 			data.IPAddresses, diags = types.ListValueFrom(ctx, types.StringType, []string{})
 			resp.Diagnostics.Append(diags...)
 		}
+
+		resp.Diagnostics.Append(clearProvisioningIncomplete(ctx, resp.Private)...)
 	} else {
 		// When skipping wait, set IPAddresses to empty list (Terraform requires all computed values to be known after apply)
 		var diags diag.Diagnostics
@@ -275,10 +382,6 @@ func (r *CloudComputeResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *CloudComputeResource) waitInstanceStatus(ctx context.Context, id int64, status string) (*client.CloudService, error) {
-	// Set a default timeout of 15 minutes for instance provisioning
-	ctx, cancel := context.WithTimeout(ctx, 15*time.Minute)
-	defer cancel()
-
 	for {
 		select {
 		case <-ctx.Done():
@@ -292,13 +395,13 @@ func (r *CloudComputeResource) waitInstanceStatus(ctx context.Context, id int64,
 		}
 
 		if res.StatusCode() != http.StatusOK {
-			return nil, fmt.Errorf("v2 metal returned an error: %s", string(res.Body))
+			return nil, fmt.Errorf("get instance: %w", newAPIError(res.StatusCode(), res.Body))
 		}
 
-		gotStatus := res.JSON200.Result.Status
-		if gotStatus == nil {
+		if res.JSON200 == nil || res.JSON200.Result == nil || res.JSON200.Result.Status == nil {
 			continue
 		}
+		gotStatus := res.JSON200.Result.Status
 
 		if *gotStatus != status {
 			tflog.Debug(ctx, "waiting for instance status %q, current status %q\n", map[string]interface{}{
@@ -322,9 +425,105 @@ func (r *CloudComputeResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	res, err := r.providerData.client.GetV2InstanceIdWithResponse(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get v2 instance, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() == http.StatusNotFound {
+		// Resource no longer exists, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("get instance", res.StatusCode(), res.Body, req.State.Raw)...)
+		return
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		resp.Diagnostics.AddError("Client Error", "Instance not found")
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, res.JSON200.Result)...)
+
+	// Forget that provisioning didn't finish once the instance becomes active
+	incomplete, diags := provisioningIncomplete(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if incomplete && res.JSON200.Result.Status != nil && *res.JSON200.Result.Status == "Active" {
+		tflog.Debug(ctx, "instance provisioning finished", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.Diagnostics.Append(clearProvisioningIncomplete(ctx, resp.Private)...)
+	}
+
+	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// refresh reconciles the model with the instance as reported by the API.
+func (m *CloudComputeResourceModel) refresh(ctx context.Context, instance *client.CloudService) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if instance.Id != nil {
+		m.ID = types.Int64Value(*instance.Id)
+	}
+
+	// Optional attributes are only reconciled once they are tracked, otherwise
+	// an omitted project_id or image_id would show up as a diff.
+	if instance.ProjectId != nil && !m.ProjectID.IsNull() {
+		m.ProjectID = types.Int64Value(*instance.ProjectId)
+	}
+
+	if instance.RegionId != nil {
+		m.RegionID = types.StringValue(*instance.RegionId)
+	}
+
+	if instance.DisplayName != nil {
+		m.DisplayName = types.StringValue(*instance.DisplayName)
+	}
+
+	if instance.TierId != nil {
+		m.TierID = types.StringValue(*instance.TierId)
+	}
+
+	if instance.ImageId != nil && !m.ImageID.IsNull() {
+		m.ImageID = types.StringValue(*instance.ImageId)
+	}
+
+	tags := []string{}
+	if instance.Tags != nil {
+		tags = *instance.Tags
+	}
+	// Keep unset tags null rather than flipping them to an empty list, which
+	// would otherwise force a replacement.
+	if len(tags) > 0 || !m.Tags.IsNull() {
+		tagsList, d := types.ListValueFrom(ctx, types.StringType, tags)
+		diags.Append(d...)
+		m.Tags = tagsList
+	}
+
+	ipAddresses := []string{}
+	if instance.IpAddresses != nil {
+		ipAddresses = *instance.IpAddresses
+	}
+	ipList, d := types.ListValueFrom(ctx, types.StringType, ipAddresses)
+	diags.Append(d...)
+	m.IPAddresses = ipList
+
+	// Only reconcile definite power states, an "Unknown" state is transient.
+	if instance.PowerState != nil {
+		switch *instance.PowerState {
+		case client.PowerStateOn, client.PowerStateOff:
+			m.DesiredPowerState = types.StringValue(string(*instance.PowerState))
+		}
+	}
+
+	return diags
+}
+
 func (r *CloudComputeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan CloudComputeResourceModel
 	var state CloudComputeResourceModel
@@ -335,6 +534,15 @@ func (r *CloudComputeResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, cloudComputeTimeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Read the current state
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -342,6 +550,38 @@ func (r *CloudComputeResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	plan.IPAddresses = state.IPAddresses
+	resolvePriceEstimate(&plan.EstimatedHourly, &plan.EstimatedMonthly)
+
+	// Resume waiting for an instance that was kept (untainted) after its
+	// provisioning didn't finish on create
+	incomplete, diags := provisioningIncomplete(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if incomplete {
+		tflog.Debug(ctx, "resuming wait for instance provisioning", map[string]interface{}{
+			"id": state.ID.ValueInt64(),
+		})
+
+		final, err := r.waitInstanceStatus(ctx, state.ID.ValueInt64(), "Active")
+		if err != nil {
+			// Keep the prior state, so the next apply resumes waiting again
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to wait v2 instance ready, got error: %s", err),
+			)
+			return
+		}
+
+		if final.IpAddresses != nil {
+			plan.IPAddresses, diags = types.ListValueFrom(ctx, types.StringType, *final.IpAddresses)
+			resp.Diagnostics.Append(diags...)
+		}
+
+		resp.Diagnostics.Append(clearProvisioningIncomplete(ctx, resp.Private)...)
+	}
 
 	if !plan.DesiredPowerState.Equal(state.DesiredPowerState) {
 		var cmd client.PowerCommand
@@ -374,13 +614,16 @@ func (r *CloudComputeResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 
 		if res.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update v2 instance power status, got error: %s", string(res.Body)))
+			resp.Diagnostics.Append(apiErrorDiagnostics("update instance power state", res.StatusCode(), res.Body, req.Plan.Raw)...)
 			return
 		}
 
 		tflog.Trace(ctx, "power state updated")
 	}
 
+	// Values missing from state were adopted by this apply
+	resp.Diagnostics.Append(clearImported(ctx, resp.Private)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -395,6 +638,15 @@ func (r *CloudComputeResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, cloudComputeTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	res, err := r.providerData.client.DeleteV2InstanceIdWithResponse(ctx, data.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
@@ -404,16 +656,65 @@ func (r *CloudComputeResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to delete v2 instance, got error: %s", string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("delete instance", res.StatusCode(), res.Body, req.State.Raw)...)
 		return
 	}
 }
 
 func (r *CloudComputeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.AddError("Not supported",
-		"Compute instance resources don't currently support importing.",
-	)
-	// resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Parse the ID from string to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Invalid resource ID format: %s. Expected a numeric ID.", req.ID),
+		)
+		return
+	}
+
+	res, err := r.providerData.client.GetV2InstanceIdWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get v2 instance, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Instance %d does not exist.", id))
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("get instance", res.StatusCode(), res.Body, resp.State.Raw)...)
+		return
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		resp.Diagnostics.AddError("Client Error", "Instance not found")
+		return
+	}
+	instance := res.JSON200.Result
+
+	state := CloudComputeResourceModel{
+		ID:        types.Int64Value(id),
+		ProjectID: types.Int64PointerValue(instance.ProjectId),
+		ImageID:   types.StringPointerValue(instance.ImageId),
+
+		// The API doesn't return write-only values, or the boot disk size,
+		// so these are left null and adopted from configuration on the next apply.
+		SSHKeyIDs: types.ListNull(types.Int64Type),
+		Password:  types.StringNull(),
+		UserData:  types.StringNull(),
+		BootSize:  types.Int64Null(),
+
+		Tags:              types.ListNull(types.StringType),
+		IPAddresses:       types.ListNull(types.StringType),
+		DesiredPowerState: types.StringValue("On"),
+		SkipWaitForReady:  types.BoolValue(false),
+		Timeouts:          nullTimeouts(),
+	}
+
+	resp.Diagnostics.Append(state.refresh(ctx, instance)...)
+	resp.Diagnostics.Append(markImported(ctx, resp.Private)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
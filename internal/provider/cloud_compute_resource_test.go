@@ -60,16 +60,13 @@ func TestAccCloudComputeResource(t *testing.T) {
 				),
 			},
 			// ImportState testing
-			// {
-			// 	ResourceName:      "scaffolding_example.test",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// 	// This is not normally necessary, but is here because this
-			// 	// example code does not have an actual upstream service.
-			// 	// Once the Read method is able to refresh information from
-			// 	// the upstream service, this can be removed.
-			// 	ImportStateVerifyIgnore: []string{"configurable_attribute", "defaulted"},
-			// },
+			{
+				ResourceName:      "teraswitch_cloud_compute.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// These values are write-only or not returned by the API.
+				ImportStateVerifyIgnore: []string{"ssh_key_ids", "password", "user_data", "boot_size", "estimated_hourly_price", "estimated_monthly_price"},
+			},
 			// Update and Read testing
 			{
 				Config: cfg2.String(t),
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudComputesDataSource{}
+
+// cloudComputesPageSize is the number of instances requested per page.
+const cloudComputesPageSize = 100
+
+func NewCloudComputesDataSource() datasource.DataSource {
+	return &CloudComputesDataSource{}
+}
+
+// CloudComputesDataSource defines the data source implementation.
+type CloudComputesDataSource struct {
+	providerData *ProviderData
+}
+
+// CloudComputesDataSourceModel describes the data source data model.
+type CloudComputesDataSourceModel struct {
+	ProjectID types.Int64                   `tfsdk:"project_id"`
+	Status    types.String                  `tfsdk:"status"`
+	Region    types.String                  `tfsdk:"region"`
+	Tier      types.String                  `tfsdk:"tier"`
+	Tag       types.String                  `tfsdk:"tag"`
+	Instances []CloudComputeDataSourceModel `tfsdk:"instances"`
+}
+
+func (d *CloudComputesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_computes"
+}
+
+func (d *CloudComputesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	instanceAttributes := cloudComputeAttributes()
+	instanceAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The ID of the instance.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cloud computes data source allows you to list all cloud compute instances matching the given filters.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter instances by project. Defaults to the provider `project_id` if one is set.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Filter instances by status. Valid values are: Active, Pending, Suspended, Terminated.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Active", "Pending", "Suspended", "Terminated"),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Filter instances by region ID. May be comma separated to include multiple regions.",
+				Optional:            true,
+			},
+			"tier": schema.StringAttribute{
+				MarkdownDescription: "Filter instances by tier ID. May be comma separated to include multiple tiers.",
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Filter instances by tag. May be comma separated to include multiple tags.",
+				Optional:            true,
+			},
+			"instances": schema.ListNestedAttribute{
+				MarkdownDescription: "List of cloud compute instances matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: instanceAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *CloudComputesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *CloudComputesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudComputesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, diags := projectIDFilter(data.ProjectID, d.providerData.projectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.GetV2InstanceParams{
+		Status:    data.Status.ValueStringPointer(),
+		Region:    data.Region.ValueStringPointer(),
+		Tier:      data.Tier.ValueStringPointer(),
+		Tag:       data.Tag.ValueStringPointer(),
+		ProjectId: projectID,
+		Limit:     PtrTo(int32(cloudComputesPageSize)),
+	}
+
+	instances := []CloudComputeDataSourceModel{}
+	for skip := int32(0); ; {
+		params.Skip = PtrTo(skip)
+
+		res, err := d.providerData.client.GetV2InstanceWithResponse(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instances, got error: %s", err))
+			return
+		}
+
+		if res.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(apiErrorDiagnostics("read instances", res.StatusCode(), res.Body, req.Config.Raw)...)
+			return
+		}
+
+		if res.JSON200 == nil || res.JSON200.Result == nil || len(*res.JSON200.Result) == 0 {
+			break
+		}
+
+		for _, instance := range *res.JSON200.Result {
+			item, diags := newCloudComputeDataSourceModel(ctx, instance)
+			resp.Diagnostics.Append(diags...)
+			instances = append(instances, item)
+		}
+
+		skip += int32(len(*res.JSON200.Result))
+
+		tflog.Debug(ctx, "read instances page", map[string]interface{}{
+			"count": len(instances),
+		})
+
+		meta := res.JSON200.Metadata
+		if meta != nil && meta.TotalCount != nil {
+			if skip >= *meta.TotalCount {
+				break
+			}
+		} else if len(*res.JSON200.Result) < cloudComputesPageSize {
+			break
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Instances = instances
+
+	tflog.Trace(ctx, "read cloud computes data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
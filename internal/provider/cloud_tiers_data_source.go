@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudTiersDataSource{}
+
+func NewCloudTiersDataSource() datasource.DataSource {
+	return &CloudTiersDataSource{}
+}
+
+// CloudTiersDataSource defines the data source implementation.
+type CloudTiersDataSource struct {
+	providerData *ProviderData
+}
+
+// CloudTierModel describes a single cloud tier.
+type CloudTierModel struct {
+	ID       types.String `tfsdk:"id"`
+	Vcpus    types.Int64  `tfsdk:"vcpus"`
+	Memory   types.Int64  `tfsdk:"memory"`
+	Transfer types.Int64  `tfsdk:"transfer"`
+	Hidden   types.Bool   `tfsdk:"hidden"`
+}
+
+// CloudTiersDataSourceModel describes the data source data model.
+type CloudTiersDataSourceModel struct {
+	MinVcpus  types.Int64      `tfsdk:"min_vcpus"`
+	MinMemory types.Int64      `tfsdk:"min_memory"`
+	ID        types.String     `tfsdk:"id"`
+	Vcpus     types.Int64      `tfsdk:"vcpus"`
+	Memory    types.Int64      `tfsdk:"memory"`
+	Transfer  types.Int64      `tfsdk:"transfer"`
+	Tiers     []CloudTierModel `tfsdk:"tiers"`
+}
+
+func (d *CloudTiersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_tiers"
+}
+
+func (d *CloudTiersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cloud Tiers data source allows you to retrieve all cloud compute tiers, " +
+			"and optionally select the smallest visible tier meeting `min_vcpus` and `min_memory`.",
+
+		Attributes: map[string]schema.Attribute{
+			"min_vcpus": schema.Int64Attribute{
+				MarkdownDescription: "Select the smallest visible tier with at least this many virtual CPUs.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_memory": schema.Int64Attribute{
+				MarkdownDescription: "Select the smallest visible tier with at least this much memory in GB.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the selected tier. Only set when `min_vcpus` or `min_memory` is configured.",
+				Computed:            true,
+			},
+			"vcpus": schema.Int64Attribute{
+				MarkdownDescription: "The number of virtual CPUs of the selected tier.",
+				Computed:            true,
+			},
+			"memory": schema.Int64Attribute{
+				MarkdownDescription: "The amount of memory in GB of the selected tier.",
+				Computed:            true,
+			},
+			"transfer": schema.Int64Attribute{
+				MarkdownDescription: "The network transfer allowance of the selected tier.",
+				Computed:            true,
+			},
+			"tiers": schema.ListNestedAttribute{
+				MarkdownDescription: "List of all cloud tiers, including hidden ones.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the cloud tier (e.g., s1.1c1g).",
+							Computed:            true,
+						},
+						"vcpus": schema.Int64Attribute{
+							MarkdownDescription: "The number of virtual CPUs for the tier.",
+							Computed:            true,
+						},
+						"memory": schema.Int64Attribute{
+							MarkdownDescription: "The amount of memory in GB for the tier.",
+							Computed:            true,
+						},
+						"transfer": schema.Int64Attribute{
+							MarkdownDescription: "The network transfer allowance for the tier.",
+							Computed:            true,
+						},
+						"hidden": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tier is hidden from the tier listing. Hidden tiers are never selected.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CloudTiersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *CloudTiersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudTiersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.providerData.client.GetV2InstanceTiersWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud tiers, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("read cloud tiers", res.StatusCode(), res.Body, req.Config.Raw)...)
+		return
+	}
+
+	var tiers []client.CloudTier
+	if res.JSON200 != nil && res.JSON200.Result != nil {
+		tiers = *res.JSON200.Result
+	}
+
+	data.Tiers = make([]CloudTierModel, 0, len(tiers))
+	for _, tier := range tiers {
+		data.Tiers = append(data.Tiers, newCloudTierModel(tier))
+	}
+
+	data.ID = types.StringNull()
+	data.Vcpus = types.Int64Null()
+	data.Memory = types.Int64Null()
+	data.Transfer = types.Int64Null()
+
+	if !data.MinVcpus.IsNull() || !data.MinMemory.IsNull() {
+		selected := selectCloudTier(tiers, data.MinVcpus.ValueInt64(), data.MinMemory.ValueInt64())
+		if selected == nil {
+			resp.Diagnostics.AddError("No Matching Cloud Tier",
+				fmt.Sprintf("No visible cloud tier has at least %d vCPUs and %d GB of memory.",
+					data.MinVcpus.ValueInt64(), data.MinMemory.ValueInt64()))
+			return
+		}
+
+		model := newCloudTierModel(*selected)
+		data.ID = model.ID
+		data.Vcpus = model.Vcpus
+		data.Memory = model.Memory
+		data.Transfer = model.Transfer
+
+		tflog.Debug(ctx, "selected cloud tier", map[string]interface{}{
+			"tier_id": model.ID.ValueString(),
+		})
+	}
+
+	tflog.Trace(ctx, "read cloud tiers data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newCloudTierModel(tier client.CloudTier) CloudTierModel {
+	m := CloudTierModel{
+		ID:       types.StringPointerValue(tier.Id),
+		Vcpus:    types.Int64Null(),
+		Memory:   types.Int64Null(),
+		Transfer: types.Int64Null(),
+		Hidden:   types.BoolValue(derefOr(tier.Hidden, false)),
+	}
+
+	if tier.Vcpus != nil {
+		m.Vcpus = types.Int64Value(int64(*tier.Vcpus))
+	}
+	if tier.Memory != nil {
+		m.Memory = types.Int64Value(int64(*tier.Memory))
+	}
+	if tier.Transfer != nil {
+		m.Transfer = types.Int64Value(int64(*tier.Transfer))
+	}
+
+	return m
+}
+
+// selectCloudTier returns the smallest visible tier with at least minVcpus
+// vCPUs and minMemory memory, or nil if there is none. Tiers are ordered by
+// vCPUs, then memory, then transfer, then ID so the choice is stable.
+func selectCloudTier(tiers []client.CloudTier, minVcpus, minMemory int64) *client.CloudTier {
+	var candidates []client.CloudTier
+	for _, tier := range tiers {
+		if derefOr(tier.Hidden, false) || tier.Id == nil {
+			continue
+		}
+		if int64(derefOr(tier.Vcpus, 0)) < minVcpus || int64(derefOr(tier.Memory, 0)) < minMemory {
+			continue
+		}
+		candidates = append(candidates, tier)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if av, bv := derefOr(a.Vcpus, 0), derefOr(b.Vcpus, 0); av != bv {
+			return av < bv
+		}
+		if am, bm := derefOr(a.Memory, 0), derefOr(b.Memory, 0); am != bm {
+			return am < bm
+		}
+		if at, bt := derefOr(a.Transfer, 0), derefOr(b.Transfer, 0); at != bt {
+			return at < bt
+		}
+		return *a.Id < *b.Id
+	})
+
+	return &candidates[0]
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSelectCloudTier(t *testing.T) {
+	tier := func(id string, vcpus, memory int32, hidden bool) client.CloudTier {
+		return client.CloudTier{Id: PtrTo(id), Vcpus: PtrTo(vcpus), Memory: PtrTo(memory), Hidden: PtrTo(hidden)}
+	}
+	tiers := []client.CloudTier{
+		tier("s1.4c8g", 4, 8, false),
+		tier("s1.2c4g", 2, 4, false),
+		tier("s1.2c2g", 2, 2, true),
+		tier("s1.1c1g", 1, 1, false),
+		tier("s1.2c8g", 2, 8, false),
+	}
+
+	tests := []struct {
+		name      string
+		minVcpus  int64
+		minMemory int64
+		want      string
+	}{
+		{"no constraints", 0, 0, "s1.1c1g"},
+		{"vcpus only", 2, 0, "s1.2c4g"},
+		{"skips hidden", 2, 2, "s1.2c4g"},
+		{"memory drives choice", 2, 6, "s1.2c8g"},
+		{"both", 3, 1, "s1.4c8g"},
+		{"none", 8, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectCloudTier(tiers, tt.minVcpus, tt.minMemory)
+			if tt.want == "" {
+				if got != nil {
+					t.Fatalf("expected no tier, got %q", *got.Id)
+				}
+				return
+			}
+			if got == nil || *got.Id != tt.want {
+				t.Fatalf("expected %q, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAccCloudTiersDataSource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTiersDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teraswitch_cloud_tiers.all", "tiers.#"),
+					resource.TestCheckNoResourceAttr("data.teraswitch_cloud_tiers.all", "id"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_cloud_tiers.small", "id"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_cloud_tiers.small", "vcpus"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudTiersDataSourceConfig() string {
+	return `
+provider "teraswitch" {}
+
+data "teraswitch_cloud_tiers" "all" {}
+
+data "teraswitch_cloud_tiers" "small" {
+  min_vcpus  = 2
+  min_memory = 4
+}
+`
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImageDataSource{}
+
+// imageVersionLatest selects the newest version of an operating system.
+const imageVersionLatest = "latest"
+
+func NewImageDataSource() datasource.DataSource {
+	return &ImageDataSource{}
+}
+
+// ImageDataSource defines the data source implementation.
+type ImageDataSource struct {
+	providerData *ProviderData
+}
+
+// ImageDataSourceModel describes the data source data model.
+type ImageDataSourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	OperatingSystemName      types.String `tfsdk:"operating_system_name"`
+	Version                  types.String `tfsdk:"version"`
+	DisplayName              types.String `tfsdk:"display_name"`
+	OperatingSystemVersion   types.String `tfsdk:"operating_system_version"`
+	SupportsCustomPartitions types.Bool   `tfsdk:"supports_custom_partitions"`
+	MetalCloudInit           types.String `tfsdk:"metal_cloud_init"`
+	MetalUefiCloudInit       types.String `tfsdk:"metal_uefi_cloud_init"`
+}
+
+func (d *ImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := imageAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the image to look up. Conflicts with `operating_system_name`.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("operating_system_name")),
+		},
+	}
+	attributes["operating_system_name"] = schema.StringAttribute{
+		MarkdownDescription: "Select an image by operating system name, like `Ubuntu`. Matching is case-insensitive.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["version"] = schema.StringAttribute{
+		MarkdownDescription: "The operating system version to select when using `operating_system_name`. " +
+			"Either an exact version like `24.04 LTS`, a constraint like `>= 22.04, < 24.10`, or `latest`. " +
+			"Constraints compare the leading numeric part of the version. When several images match, " +
+			"the newest is selected. Defaults to `latest`.",
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("operating_system_name")),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Image data source allows you to look up an OS image by ID, or by operating system name and version.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *ImageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	images, err := listImages(ctx, d.providerData.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read images, got error: %s", err))
+		return
+	}
+
+	var image *client.Image
+	if !data.ID.IsNull() {
+		for i := range images {
+			if derefOr(images[i].Id, "") == data.ID.ValueString() {
+				image = &images[i]
+				break
+			}
+		}
+		if image == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Image Not Found",
+				fmt.Sprintf("No image with ID %q exists.", data.ID.ValueString()))
+			return
+		}
+	} else {
+		candidates := filterImagesByOS(images, data.OperatingSystemName.ValueString())
+		image, err = selectImageVersion(candidates, data.Version.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version"), "Invalid Image Version", err.Error())
+			return
+		}
+		if image == nil {
+			resp.Diagnostics.AddError("Image Not Found",
+				fmt.Sprintf("No %s image matches version %q.", data.OperatingSystemName.ValueString(), data.Version.ValueString()))
+			return
+		}
+	}
+
+	m := newImageModel(*image)
+	data.ID = m.ID
+	data.DisplayName = m.DisplayName
+	data.OperatingSystemName = m.OperatingSystemName
+	data.OperatingSystemVersion = m.OperatingSystemVersion
+	data.SupportsCustomPartitions = m.SupportsCustomPartitions
+	data.MetalCloudInit = m.MetalCloudInit
+	data.MetalUefiCloudInit = m.MetalUefiCloudInit
+
+	tflog.Trace(ctx, "read image data source", map[string]interface{}{
+		"image_id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// imageVersionRegexp matches the leading numeric part of an OS version, so
+// "24.04 LTS" compares as 24.4 and "2019 Standard" as 2019.
+var imageVersionRegexp = regexp.MustCompile(`^\d+(\.\d+)*`)
+
+// parseImageVersion returns the comparable version of an image, or nil if its
+// version has no numeric prefix.
+func parseImageVersion(image client.Image) *version.Version {
+	raw := imageVersionRegexp.FindString(strings.TrimSpace(derefOr(image.OperatingSystemVersion, "")))
+	if raw == "" {
+		return nil
+	}
+	v, err := version.NewVersion(raw)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+// selectImageVersion picks the image matching want: an exact version string,
+// a version constraint, or "latest" (also the empty string). When several
+// images match, the one with the highest version wins.
+func selectImageVersion(images []client.Image, want string) (*client.Image, error) {
+	want = strings.TrimSpace(want)
+
+	for i := range images {
+		if want != "" && strings.EqualFold(derefOr(images[i].OperatingSystemVersion, ""), want) {
+			return &images[i], nil
+		}
+	}
+
+	var constraints version.Constraints
+	if want != "" && !strings.EqualFold(want, imageVersionLatest) {
+		var err error
+		constraints, err = version.NewConstraint(want)
+		if err != nil {
+			return nil, fmt.Errorf("version must be an exact version, a version constraint or %q: %s", imageVersionLatest, err)
+		}
+	}
+
+	type candidate struct {
+		image   *client.Image
+		version *version.Version
+	}
+	var candidates []candidate
+	for i := range images {
+		v := parseImageVersion(images[i])
+		if constraints != nil && (v == nil || !constraints.Check(v)) {
+			continue
+		}
+		candidates = append(candidates, candidate{image: &images[i], version: v})
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	// Newest first; images without a numeric version sort last, ties by ID.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		switch {
+		case a.version == nil && b.version == nil:
+		case a.version == nil:
+			return false
+		case b.version == nil:
+			return true
+		case !a.version.Equal(b.version):
+			return a.version.GreaterThan(b.version)
+		}
+		return derefOr(a.image.Id, "") < derefOr(b.image.Id, "")
+	})
+
+	return candidates[0].image, nil
+}
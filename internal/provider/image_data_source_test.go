@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSelectImageVersion(t *testing.T) {
+	image := func(id, version string) client.Image {
+		return client.Image{Id: PtrTo(id), OperatingSystemName: PtrTo("Ubuntu"), OperatingSystemVersion: PtrTo(version)}
+	}
+	images := []client.Image{
+		image("ubuntu-jammy", "22.04 LTS"),
+		image("ubuntu-noble", "24.04 LTS"),
+		image("ubuntu-focal", "20.04 LTS"),
+		image("ubuntu-oracular", "24.10"),
+		image("ubuntu-daily", "daily"),
+	}
+
+	tests := []struct {
+		name    string
+		want    string
+		expect  string
+		wantErr bool
+	}{
+		{"empty is latest", "", "ubuntu-oracular", false},
+		{"latest", "latest", "ubuntu-oracular", false},
+		{"exact", "22.04 LTS", "ubuntu-jammy", false},
+		{"exact case-insensitive", "22.04 lts", "ubuntu-jammy", false},
+		{"exact non-numeric", "daily", "ubuntu-daily", false},
+		{"constraint", "< 24.10", "ubuntu-noble", false},
+		{"range", ">= 20.04, < 24", "ubuntu-jammy", false},
+		{"bare number", "20.04", "ubuntu-focal", false},
+		{"no match", "> 30", "", false},
+		{"invalid", "noble", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectImageVersion(images, tt.want)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tt.expect == "" {
+				if got != nil {
+					t.Fatalf("expected no image, got %q", *got.Id)
+				}
+				return
+			}
+			if got == nil || *got.Id != tt.expect {
+				t.Fatalf("expected %q, got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestAccImageDataSource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teraswitch_images.all", "images.#"),
+					resource.TestCheckResourceAttr("data.teraswitch_image.by_id", "id", "ubuntu-noble"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_image.by_id", "operating_system_version"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_image.by_id", "supports_custom_partitions"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_image.latest", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageDataSourceConfig() string {
+	return `
+provider "teraswitch" {}
+
+data "teraswitch_images" "all" {}
+
+data "teraswitch_image" "by_id" {
+  id = "ubuntu-noble"
+}
+
+data "teraswitch_image" "latest" {
+  operating_system_name = "Ubuntu"
+  version               = "latest"
+}
+`
+}
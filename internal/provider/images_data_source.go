@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImagesDataSource{}
+
+func NewImagesDataSource() datasource.DataSource {
+	return &ImagesDataSource{}
+}
+
+// ImagesDataSource defines the data source implementation.
+type ImagesDataSource struct {
+	providerData *ProviderData
+}
+
+// ImageModel describes a single OS image.
+type ImageModel struct {
+	ID                       types.String `tfsdk:"id"`
+	DisplayName              types.String `tfsdk:"display_name"`
+	OperatingSystemName      types.String `tfsdk:"operating_system_name"`
+	OperatingSystemVersion   types.String `tfsdk:"operating_system_version"`
+	SupportsCustomPartitions types.Bool   `tfsdk:"supports_custom_partitions"`
+	MetalCloudInit           types.String `tfsdk:"metal_cloud_init"`
+	MetalUefiCloudInit       types.String `tfsdk:"metal_uefi_cloud_init"`
+}
+
+// ImagesDataSourceModel describes the data source data model.
+type ImagesDataSourceModel struct {
+	OperatingSystemName types.String `tfsdk:"operating_system_name"`
+	Images              []ImageModel `tfsdk:"images"`
+}
+
+func (d *ImagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_images"
+}
+
+// imageAttributes returns the computed attributes describing an OS image.
+func imageAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the image, for use as `image_id`.",
+			Computed:            true,
+		},
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "Human-readable name of the image.",
+			Computed:            true,
+		},
+		"operating_system_name": schema.StringAttribute{
+			MarkdownDescription: "Base name of the operating system, like `Ubuntu` or `Windows Server`.",
+			Computed:            true,
+		},
+		"operating_system_version": schema.StringAttribute{
+			MarkdownDescription: "Specific version of the operating system, like `24.04 LTS` or `2019 Standard`.",
+			Computed:            true,
+		},
+		"supports_custom_partitions": schema.BoolAttribute{
+			MarkdownDescription: "Whether the image can be installed with custom `partitions` and `raid_arrays` on metal services.",
+			Computed:            true,
+		},
+		"metal_cloud_init": schema.StringAttribute{
+			MarkdownDescription: "The cloud-init template used when installing the image on metal services.",
+			Computed:            true,
+		},
+		"metal_uefi_cloud_init": schema.StringAttribute{
+			MarkdownDescription: "The cloud-init template used when installing the image on UEFI metal services.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *ImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Images data source allows you to retrieve all available OS images.",
+
+		Attributes: map[string]schema.Attribute{
+			"operating_system_name": schema.StringAttribute{
+				MarkdownDescription: "Filter images by operating system name, like `Ubuntu`. Matching is case-insensitive.",
+				Optional:            true,
+			},
+			"images": schema.ListNestedAttribute{
+				MarkdownDescription: "List of available images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: imageAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ImagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	images, err := listImages(ctx, d.providerData.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read images, got error: %s", err))
+		return
+	}
+
+	data.Images = []ImageModel{}
+	for _, image := range filterImagesByOS(images, data.OperatingSystemName.ValueString()) {
+		data.Images = append(data.Images, newImageModel(image))
+	}
+
+	tflog.Trace(ctx, "read images data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listImages returns all images known to the API.
+func listImages(ctx context.Context, c *client.ClientWithResponses) ([]client.Image, error) {
+	res, err := c.GetV2ImageWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("list images: %w", newAPIError(res.StatusCode(), res.Body))
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		return nil, nil
+	}
+
+	return *res.JSON200.Result, nil
+}
+
+// filterImagesByOS returns the images whose operating system name matches
+// name case-insensitively. An empty name matches every image.
+func filterImagesByOS(images []client.Image, name string) []client.Image {
+	if name == "" {
+		return images
+	}
+
+	var matched []client.Image
+	for _, image := range images {
+		if strings.EqualFold(derefOr(image.OperatingSystemName, ""), name) {
+			matched = append(matched, image)
+		}
+	}
+	return matched
+}
+
+func newImageModel(image client.Image) ImageModel {
+	customStorage := !derefOr(image.DisableCustomizableStorage, false)
+
+	return ImageModel{
+		ID:                       types.StringPointerValue(image.Id),
+		DisplayName:              types.StringPointerValue(image.DisplayName),
+		OperatingSystemName:      types.StringPointerValue(image.OperatingSystemName),
+		OperatingSystemVersion:   types.StringPointerValue(image.OperatingSystemVersion),
+		SupportsCustomPartitions: types.BoolValue(customStorage),
+		MetalCloudInit:           types.StringPointerValue(image.MetalCloudInit),
+		MetalUefiCloudInit:       types.StringPointerValue(image.MetalUefiCloudInit),
+	}
+}
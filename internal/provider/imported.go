@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// privateKeyImported is the private state key recording that a server was
+// imported and hasn't been applied since. The API doesn't return some of its
+// values, so they are null in state until that first apply adopts them from
+// the configuration.
+const privateKeyImported = "imported"
+
+func markImported(ctx context.Context, private privateStateSetter) diag.Diagnostics {
+	return private.SetKey(ctx, privateKeyImported, []byte(`true`))
+}
+
+func clearImported(ctx context.Context, private privateStateSetter) diag.Diagnostics {
+	// Setting a nil value removes the key
+	return private.SetKey(ctx, privateKeyImported, nil)
+}
+
+func imported(ctx context.Context, private privateStateGetter) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateKeyImported)
+	return len(value) > 0, diags
+}
+
+// adoptsImportedValue reports whether a value is adopted from the
+// configuration rather than applied, which is the case when it is missing
+// from the state of a server that was just imported.
+func adoptsImportedValue(ctx context.Context, private privateStateGetter, state attr.Value) (bool, diag.Diagnostics) {
+	if !state.IsNull() {
+		return false, nil
+	}
+
+	return imported(ctx, private)
+}
+
+func requiresReplaceUnlessImportedString(description string) planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			adopt, diags := adoptsImportedValue(ctx, req.Private, req.StateValue)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !adopt
+		},
+		description,
+		description,
+	)
+}
+
+func requiresReplaceUnlessImportedInt64(description string) planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			adopt, diags := adoptsImportedValue(ctx, req.Private, req.StateValue)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !adopt
+		},
+		description,
+		description,
+	)
+}
+
+func requiresReplaceUnlessImportedList(description string) planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			adopt, diags := adoptsImportedValue(ctx, req.Private, req.StateValue)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !adopt
+		},
+		description,
+		description,
+	)
+}
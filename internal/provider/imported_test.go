@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if value == nil {
+		delete(p, key)
+		return nil
+	}
+	p[key] = value
+	return nil
+}
+
+func TestAdoptsImportedValue(t *testing.T) {
+	ctx := context.Background()
+
+	importedPrivate := testPrivateState{}
+	markImported(ctx, importedPrivate)
+
+	appliedPrivate := testPrivateState{}
+	markImported(ctx, appliedPrivate)
+	clearImported(ctx, appliedPrivate)
+
+	tests := []struct {
+		name    string
+		private testPrivateState
+		state   attr.Value
+		want    bool
+	}{
+		{"imported, missing from state", importedPrivate, types.StringNull(), true},
+		{"imported, in state", importedPrivate, types.StringValue("#cloud-config"), false},
+		{"created, missing from state", testPrivateState{}, types.StringNull(), false},
+		{"applied since import", appliedPrivate, types.StringNull(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := adoptsImportedValue(ctx, tt.private, tt.state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InstanceNetworkAttachmentResource{}
+var _ resource.ResourceWithImportState = &InstanceNetworkAttachmentResource{}
+
+func NewInstanceNetworkAttachmentResource() resource.Resource {
+	return &InstanceNetworkAttachmentResource{}
+}
+
+// instanceNetworkAttachmentTimeouts are the default deadlines of the resource's operations.
+var instanceNetworkAttachmentTimeouts = resourceTimeouts{
+	Create: 10 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
+// InstanceNetworkAttachmentResource defines the resource implementation.
+type InstanceNetworkAttachmentResource struct {
+	providerData *ProviderData
+}
+
+// InstanceNetworkAttachmentResourceModel describes the resource data model.
+type InstanceNetworkAttachmentResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	InstanceID types.Int64    `tfsdk:"instance_id"`
+	NetworkID  types.String   `tfsdk:"network_id"`
+	RegionID   types.String   `tfsdk:"region_id"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *InstanceNetworkAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_network_attachment"
+}
+
+func (r *InstanceNetworkAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches a cloud compute instance to a private network.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the attachment in the form `instance_id/network_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the cloud compute instance to attach to the network.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the network to attach the instance to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the region that the network is in.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, instanceNetworkAttachmentTimeouts),
+		},
+	}
+}
+
+func (r *InstanceNetworkAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = client
+}
+
+func (r *InstanceNetworkAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InstanceNetworkAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, instanceNetworkAttachmentTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	res, err := r.providerData.client.PostV2InstanceInstanceIdNetworksAttachWithResponse(ctx, data.InstanceID.ValueInt64(),
+		&client.PostV2InstanceInstanceIdNetworksAttachParams{
+			ProjectId: &r.providerData.projectID,
+		}, client.AttachToNetworkRequest{
+			NetworkId: data.NetworkID.ValueStringPointer(),
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach network, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("attach network", res.StatusCode(), res.Body, req.Plan.Raw)...)
+		return
+	}
+
+	network, err := r.waitNetwork(ctx, data.InstanceID.ValueInt64(), data.NetworkID.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to wait for network attachment, got error: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(instanceNetworkAttachmentID(data.InstanceID.ValueInt64(), data.NetworkID.ValueString()))
+	data.RegionID = types.StringPointerValue(network.RegionId)
+
+	tflog.Trace(ctx, "attached instance to network")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceNetworkAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InstanceNetworkAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := r.findNetwork(ctx, data.InstanceID.ValueInt64(), data.NetworkID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instance networks, got error: %s", err))
+		return
+	}
+
+	if network == nil {
+		// Instance is no longer on the network, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(instanceNetworkAttachmentID(data.InstanceID.ValueInt64(), data.NetworkID.ValueString()))
+	data.RegionID = types.StringPointerValue(network.RegionId)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceNetworkAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InstanceNetworkAttachmentResourceModel
+
+	// All configurable attributes require replacement, so this only persists the plan.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceNetworkAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InstanceNetworkAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, instanceNetworkAttachmentTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	res, err := r.providerData.client.PostV2InstanceInstanceIdNetworksDetachWithResponse(ctx, data.InstanceID.ValueInt64(),
+		&client.PostV2InstanceInstanceIdNetworksDetachParams{
+			ProjectId: &r.providerData.projectID,
+		}, client.DetachFromNetworkRequest{
+			NetworkId: data.NetworkID.ValueStringPointer(),
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach network, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() == http.StatusNotFound {
+		// Instance or network already gone, nothing to do
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("detach network", res.StatusCode(), res.Body, req.State.Raw)...)
+		return
+	}
+
+	if _, err := r.waitNetwork(ctx, data.InstanceID.ValueInt64(), data.NetworkID.ValueString(), false); err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to wait for network detachment, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "detached instance from network")
+}
+
+func (r *InstanceNetworkAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceIDStr, networkID, ok := strings.Cut(req.ID, "/")
+	instanceID, err := strconv.ParseInt(instanceIDStr, 10, 64)
+	if !ok || networkID == "" || err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Invalid resource ID format: %s. Expected instance_id/network_id.", req.ID),
+		)
+		return
+	}
+
+	state := InstanceNetworkAttachmentResourceModel{
+		ID:         types.StringValue(instanceNetworkAttachmentID(instanceID, networkID)),
+		InstanceID: types.Int64Value(instanceID),
+		NetworkID:  types.StringValue(networkID),
+		RegionID:   types.StringNull(),
+		Timeouts:   nullTimeouts(),
+	}
+
+	// Set the state directly - this will trigger a Read to populate the rest
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func instanceNetworkAttachmentID(instanceID int64, networkID string) string {
+	return fmt.Sprintf("%d/%s", instanceID, networkID)
+}
+
+// findNetwork pages through the networks the instance is attached to and
+// returns the one matching networkID, or nil if the instance isn't on it.
+func (r *InstanceNetworkAttachmentResource) findNetwork(ctx context.Context, instanceID int64, networkID string) (*client.Details, error) {
+	const pageSize = 100
+
+	var skip int32
+	for {
+		res, err := r.providerData.client.GetV2InstanceInstanceIdNetworksWithResponse(ctx, strconv.FormatInt(instanceID, 10),
+			&client.GetV2InstanceInstanceIdNetworksParams{
+				ProjectId:  &r.providerData.projectID,
+				InstanceId: &instanceID,
+				Limit:      PtrTo(int32(pageSize)),
+				Skip:       &skip,
+			})
+		if err != nil {
+			return nil, fmt.Errorf("send list instance networks request: %w", err)
+		}
+
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+
+		if res.StatusCode() != http.StatusOK {
+			return nil, fmt.Errorf("list instance networks: %w", newAPIError(res.StatusCode(), res.Body))
+		}
+
+		if res.JSON200 == nil || res.JSON200.Result == nil {
+			return nil, nil
+		}
+
+		for _, network := range *res.JSON200.Result {
+			if network.NetworkId != nil && *network.NetworkId == networkID {
+				return &network, nil
+			}
+		}
+
+		skip += int32(len(*res.JSON200.Result))
+		if len(*res.JSON200.Result) < pageSize ||
+			(res.JSON200.Metadata != nil && res.JSON200.Metadata.TotalCount != nil && skip >= *res.JSON200.Metadata.TotalCount) {
+			return nil, nil
+		}
+	}
+}
+
+// waitNetwork waits until the instance is attached to (or detached from) the
+// network.
+func (r *InstanceNetworkAttachmentResource) waitNetwork(ctx context.Context, instanceID int64, networkID string, attached bool) (*client.Details, error) {
+	for {
+		network, err := r.findNetwork(ctx, instanceID, networkID)
+		if err != nil {
+			return nil, err
+		}
+
+		if (network != nil) == attached {
+			return network, nil
+		}
+
+		tflog.Debug(ctx, "waiting for instance network attachment", map[string]interface{}{
+			"instance_id": instanceID,
+			"network_id":  networkID,
+			"attached":    attached,
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout waiting for instance network attachment: %w", ctx.Err())
+		case <-time.After(3 * time.Second):
+		}
+	}
+}
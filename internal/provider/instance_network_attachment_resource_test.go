@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInstanceNetworkAttachmentResource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInstanceNetworkAttachmentResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("teraswitch_instance_network_attachment.test", "instance_id", "teraswitch_cloud_compute.test", "id"),
+					resource.TestCheckResourceAttrPair("teraswitch_instance_network_attachment.test", "network_id", "teraswitch_network.test", "id"),
+					resource.TestCheckResourceAttr("teraswitch_instance_network_attachment.test", "region_id", "PIT1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "teraswitch_instance_network_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported attachments have no timeouts block.
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccInstanceNetworkAttachmentResourceConfig() string {
+	return `
+provider "teraswitch" {}
+
+resource "teraswitch_network" "test" {
+  region_id      = "PIT1"
+  display_name   = "yeehaw"
+  v4_subnet      = "10.99.0.0"
+  v4_subnet_mask = "24"
+}
+
+resource "teraswitch_cloud_compute" "test" {
+  region_id    = "PIT1"
+  tier_id      = "s1.1c1g"
+  image_id     = "ubuntu-noble"
+  display_name = "yeehaw"
+  boot_size    = 64
+  ssh_key_ids  = [588]
+}
+
+resource "teraswitch_instance_network_attachment" "test" {
+  instance_id = teraswitch_cloud_compute.test.id
+  network_id  = teraswitch_network.test.id
+
+  timeouts {
+    create = "15m"
+    delete = "15m"
+  }
+}
+`
+}
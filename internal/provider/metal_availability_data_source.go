@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetalAvailabilityDataSource{}
+
+func NewMetalAvailabilityDataSource() datasource.DataSource {
+	return &MetalAvailabilityDataSource{}
+}
+
+// MetalAvailabilityDataSource defines the data source implementation.
+type MetalAvailabilityDataSource struct {
+	providerData *ProviderData
+}
+
+// MetalAvailabilityDataSourceModel describes the data source data model.
+type MetalAvailabilityDataSourceModel struct {
+	ProjectID    types.Int64  `tfsdk:"project_id"`
+	Region       types.String `tfsdk:"region"`
+	Availability types.Map    `tfsdk:"availability"`
+}
+
+func (d *MetalAvailabilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metal_availability"
+}
+
+func (d *MetalAvailabilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Metal Availability data source allows you to retrieve how many metal servers of each tier can currently be deployed in each region.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the project to query availability for. Defaults to the provider `project_id`.",
+				Optional:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only return availability in this region.",
+				Optional:            true,
+			},
+			"availability": schema.MapAttribute{
+				MarkdownDescription: "The maximum number of servers that can be deployed, keyed by region ID and then by tier ID. " +
+					"Tiers that are sold out in a region are reported with a quantity of 0.",
+				Computed:    true,
+				ElementType: types.MapType{ElemType: types.Int64Type},
+			},
+		},
+	}
+}
+
+func (d *MetalAvailabilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *MetalAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetalAvailabilityDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := d.providerData.projectID
+	if !data.ProjectID.IsNull() {
+		projectID = data.ProjectID.ValueInt64()
+	}
+	if projectID == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("project_id"), "Missing Project ID",
+			"Availability is queried per project. Set project_id on the data source or the provider.")
+		return
+	}
+
+	availability, err := readMetalAvailability(ctx, d.providerData.client, projectID, data.Region.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metal availability, got error: %s", err))
+		return
+	}
+
+	if !data.Region.IsNull() {
+		tiers := availability[data.Region.ValueString()]
+		if tiers == nil {
+			tiers = map[string]int64{}
+		}
+		availability = map[string]map[string]int64{data.Region.ValueString(): tiers}
+	}
+
+	availabilityMap, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.Int64Type}, availability)
+	resp.Diagnostics.Append(diags...)
+	data.Availability = availabilityMap
+
+	tflog.Trace(ctx, "read metal availability data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readMetalAvailability returns the maximum deployable quantity of each tier,
+// keyed by region and then tier. A nil region queries every region.
+func readMetalAvailability(ctx context.Context, c *client.ClientWithResponses, projectID int64, region *string) (map[string]map[string]int64, error) {
+	res, err := c.GetV2MetalAvailabilityWithResponse(ctx, &client.GetV2MetalAvailabilityParams{
+		ProjectId: projectID,
+		Region:    region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("get metal availability: %w", newAPIError(res.StatusCode(), res.Body))
+	}
+
+	var configs []client.MetalConfiguration
+	if res.JSON200 != nil && res.JSON200.Result != nil {
+		configs = *res.JSON200.Result
+	}
+
+	return metalAvailabilityMatrix(configs, derefOr(region, "")), nil
+}
+
+// metalAvailabilityMatrix folds the configurations returned by the API into
+// region → tier → max quantity. A tier offered in several configurations
+// reports its largest quantity. region is used for configurations whose tier
+// carries no per-region availability.
+func metalAvailabilityMatrix(configs []client.MetalConfiguration, region string) map[string]map[string]int64 {
+	matrix := map[string]map[string]int64{}
+	set := func(region, tier string, quantity int64) {
+		if matrix[region] == nil {
+			matrix[region] = map[string]int64{}
+		}
+		if current, ok := matrix[region][tier]; !ok || quantity > current {
+			matrix[region][tier] = quantity
+		}
+	}
+
+	for _, config := range configs {
+		if config.Tier == nil || config.Tier.Id == nil {
+			continue
+		}
+		tierID := *config.Tier.Id
+
+		if config.Tier.Availability != nil && len(*config.Tier.Availability) > 0 {
+			for r, a := range *config.Tier.Availability {
+				set(r, tierID, int64(derefOr(a.MaxQuantity, 0)))
+			}
+		} else if region != "" {
+			set(region, tierID, int64(derefOr(config.Quantity, 0)))
+		}
+	}
+
+	return matrix
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMetalAvailabilityMatrix(t *testing.T) {
+	availability := func(quantities map[string]int32) *map[string]client.ServiceAvailability {
+		m := map[string]client.ServiceAvailability{}
+		for region, q := range quantities {
+			m[region] = client.ServiceAvailability{MaxQuantity: PtrTo(q)}
+		}
+		return &m
+	}
+	configs := []client.MetalConfiguration{
+		{Tier: &client.MetalTier{Id: PtrTo("7950x"), Availability: availability(map[string]int32{"SLC1": 2, "PIT1": 0})}},
+		{Tier: &client.MetalTier{Id: PtrTo("7950x"), Availability: availability(map[string]int32{"SLC1": 5})}},
+		{Tier: &client.MetalTier{Id: PtrTo("2388g")}, Quantity: PtrTo(int32(1))},
+		{Quantity: PtrTo(int32(9))},
+	}
+
+	got := metalAvailabilityMatrix(configs, "LAX1")
+
+	want := map[string]map[string]int64{
+		"SLC1": {"7950x": 5},
+		"PIT1": {"7950x": 0},
+		"LAX1": {"2388g": 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for region, tiers := range want {
+		for tier, quantity := range tiers {
+			if got[region][tier] != quantity {
+				t.Fatalf("expected %s/%s = %d, got %v", region, tier, quantity, got)
+			}
+		}
+	}
+}
+
+func TestAccMetalAvailabilityDataSource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetalAvailabilityDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal_availability.test", "availability.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetalAvailabilityDataSourceConfig() string {
+	return `
+provider "teraswitch" {}
+
+data "teraswitch_metal_availability" "test" {
+  project_id = 480
+}
+`
+}
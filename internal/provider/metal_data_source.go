@@ -37,6 +37,11 @@ type MetalDataSourceModel struct {
 	IPAddresses        types.List    `tfsdk:"ip_addresses"`
 	IPv4DefaultGateway types.String  `tfsdk:"ipv4_default_gateway"`
 	IPv6DefaultGateway types.String  `tfsdk:"ipv6_default_gateway"`
+	IPv4Addresses      types.List    `tfsdk:"ipv4_addresses"`
+	IPv6Addresses      types.List    `tfsdk:"ipv6_addresses"`
+	Interfaces         types.List    `tfsdk:"interfaces"`
+	StorageDevices     types.Map     `tfsdk:"storage_devices"`
+	Device             types.Object  `tfsdk:"device"`
 	MemoryGB           types.Int64   `tfsdk:"memory_gb"`
 	Tags               types.List    `tfsdk:"tags"`
 	ReservePricing     types.Bool    `tfsdk:"reserve_pricing"`
@@ -141,6 +146,10 @@ func (d *MetalDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			},
 		},
 	}
+
+	for name, attribute := range metalHardwareDataSourceAttributes(metalHardwareAttributes) {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (d *MetalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -179,9 +188,7 @@ func (d *MetalDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to read metal service, got error: %s", string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("read metal service", res.StatusCode(), res.Body, req.Config.Raw)...)
 		return
 	}
 
@@ -249,17 +256,15 @@ func (d *MetalDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.IPAddresses = types.ListNull(types.StringType)
 	}
 
-	if metalService.Ipv4DefaultGateway != nil {
-		data.IPv4DefaultGateway = types.StringValue(*metalService.Ipv4DefaultGateway)
-	} else {
-		data.IPv4DefaultGateway = types.StringNull()
-	}
-
-	if metalService.Ipv6DefaultGateway != nil {
-		data.IPv6DefaultGateway = types.StringValue(*metalService.Ipv6DefaultGateway)
-	} else {
-		data.IPv6DefaultGateway = types.StringNull()
-	}
+	hardware, diags := newMetalHardware(ctx, *metalService)
+	resp.Diagnostics.Append(diags...)
+	data.IPv4Addresses = hardware.IPv4Addresses
+	data.IPv6Addresses = hardware.IPv6Addresses
+	data.IPv4DefaultGateway = hardware.IPv4DefaultGateway
+	data.IPv6DefaultGateway = hardware.IPv6DefaultGateway
+	data.Interfaces = hardware.Interfaces
+	data.StorageDevices = hardware.StorageDevices
+	data.Device = hardware.Device
 
 	if metalService.MemoryGb != nil {
 		data.MemoryGB = types.Int64Value(int64(*metalService.MemoryGb))
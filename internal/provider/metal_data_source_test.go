@@ -49,6 +49,9 @@ func TestAccMetalDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.teraswitch_metal.test", "id", *metalDataSourceCfg.MetalID),
 					resource.TestCheckResourceAttrSet("data.teraswitch_metal.test", "created"),
 					resource.TestCheckResourceAttrSet("data.teraswitch_metal.test", "memory_gb"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal.test", "ipv4_addresses.#"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal.test", "interfaces.0.mac_address"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal.test", "device.serial"),
 				),
 			},
 		},
@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// metalHardware describes the network and hardware details of a metal
+// service, shared by the teraswitch_metal resource and data source.
+type metalHardware struct {
+	IPv4Addresses      types.List
+	IPv6Addresses      types.List
+	IPv4DefaultGateway types.String
+	IPv6DefaultGateway types.String
+	Interfaces         types.List
+	StorageDevices     types.Map
+	Device             types.Object
+}
+
+type metalInterfaceModel struct {
+	Name       types.String `tfsdk:"name"`
+	MACAddress types.String `tfsdk:"mac_address"`
+	LinkSpeed  types.Int64  `tfsdk:"link_speed"`
+}
+
+type metalStorageDeviceModel struct {
+	Name       types.String `tfsdk:"name"`
+	CapacityGB types.Int64  `tfsdk:"capacity_gb"`
+	Type       types.String `tfsdk:"type"`
+}
+
+type metalMemoryModuleModel struct {
+	Name              types.String `tfsdk:"name"`
+	CapacityGB        types.Int64  `tfsdk:"capacity_gb"`
+	Manufacturer      types.String `tfsdk:"manufacturer"`
+	MemoryType        types.String `tfsdk:"memory_type"`
+	OperatingSpeedMHz types.Int64  `tfsdk:"operating_speed_mhz"`
+	PartNumber        types.String `tfsdk:"part_number"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	Status            types.String `tfsdk:"status"`
+}
+
+type metalDeviceModel struct {
+	Name           types.String  `tfsdk:"name"`
+	CPUModel       types.String  `tfsdk:"cpu_model"`
+	MemoryGB       types.Int64   `tfsdk:"memory_gb"`
+	MemoryModules  types.List    `tfsdk:"memory_modules"`
+	Serial         types.String  `tfsdk:"serial"`
+	RackName       types.String  `tfsdk:"rack_name"`
+	RackPosition   types.Float64 `tfsdk:"rack_position"`
+	RackFacilityID types.String  `tfsdk:"rack_facility_id"`
+	BMCIP          types.String  `tfsdk:"bmc_ip"`
+}
+
+var (
+	metalInterfaceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"mac_address": types.StringType,
+		"link_speed":  types.Int64Type,
+	}}
+
+	metalStorageDeviceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"capacity_gb": types.Int64Type,
+		"type":        types.StringType,
+	}}
+
+	metalMemoryModuleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":                types.StringType,
+		"capacity_gb":         types.Int64Type,
+		"manufacturer":        types.StringType,
+		"memory_type":         types.StringType,
+		"operating_speed_mhz": types.Int64Type,
+		"part_number":         types.StringType,
+		"serial_number":       types.StringType,
+		"status":              types.StringType,
+	}}
+
+	metalDeviceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":             types.StringType,
+		"cpu_model":        types.StringType,
+		"memory_gb":        types.Int64Type,
+		"memory_modules":   types.ListType{ElemType: metalMemoryModuleType},
+		"serial":           types.StringType,
+		"rack_name":        types.StringType,
+		"rack_position":    types.Float64Type,
+		"rack_facility_id": types.StringType,
+		"bmc_ip":           types.StringType,
+	}}
+)
+
+// nullMetalHardware returns hardware details with every value null.
+func nullMetalHardware() metalHardware {
+	return metalHardware{
+		IPv4Addresses:      types.ListNull(types.StringType),
+		IPv6Addresses:      types.ListNull(types.StringType),
+		IPv4DefaultGateway: types.StringNull(),
+		IPv6DefaultGateway: types.StringNull(),
+		Interfaces:         types.ListNull(metalInterfaceType),
+		StorageDevices:     types.MapNull(metalStorageDeviceType),
+		Device:             types.ObjectNull(metalDeviceType.AttrTypes),
+	}
+}
+
+// planUnknownHardware plans the hardware details as unknown, for plans that
+// reinstall the server or resume waiting for it. They otherwise keep their
+// values from state.
+func planUnknownHardware(ctx context.Context, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	unknown := map[string]attr.Value{
+		"ipv4_addresses":       types.ListUnknown(types.StringType),
+		"ipv6_addresses":       types.ListUnknown(types.StringType),
+		"ipv4_default_gateway": types.StringUnknown(),
+		"ipv6_default_gateway": types.StringUnknown(),
+		"interfaces":           types.ListUnknown(metalInterfaceType),
+		"storage_devices":      types.MapUnknown(metalStorageDeviceType),
+		"device":               types.ObjectUnknown(metalDeviceType.AttrTypes),
+	}
+	for _, name := range sortedKeys(unknown) {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root(name), unknown[name])...)
+	}
+
+	return diags
+}
+
+func newMetalHardware(ctx context.Context, service client.MetalService) (metalHardware, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	hw := nullMetalHardware()
+	hw.IPv4DefaultGateway = types.StringPointerValue(service.Ipv4DefaultGateway)
+	hw.IPv6DefaultGateway = types.StringPointerValue(service.Ipv6DefaultGateway)
+
+	if service.IpAddresses != nil {
+		v4, v6 := splitIPAddresses(*service.IpAddresses)
+		hw.IPv4Addresses, d = types.ListValueFrom(ctx, types.StringType, v4)
+		diags.Append(d...)
+		hw.IPv6Addresses, d = types.ListValueFrom(ctx, types.StringType, v6)
+		diags.Append(d...)
+	}
+
+	if service.Interfaces != nil {
+		interfaces := []metalInterfaceModel{}
+		for _, iface := range *service.Interfaces {
+			interfaces = append(interfaces, metalInterfaceModel{
+				Name:       types.StringPointerValue(iface.Name),
+				MACAddress: types.StringPointerValue(iface.MacAddress),
+				LinkSpeed:  int32Value(iface.LinkSpeed),
+			})
+		}
+		hw.Interfaces, d = types.ListValueFrom(ctx, metalInterfaceType, interfaces)
+		diags.Append(d...)
+	}
+
+	if service.StorageDevices != nil {
+		devices := map[string]metalStorageDeviceModel{}
+		for name, device := range *service.StorageDevices {
+			storageType := types.StringNull()
+			if device.Type != nil {
+				storageType = types.StringValue(string(*device.Type))
+			}
+
+			devices[name] = metalStorageDeviceModel{
+				Name:       types.StringPointerValue(device.Name),
+				CapacityGB: int32Value(device.CapacityGb),
+				Type:       storageType,
+			}
+		}
+		hw.StorageDevices, d = types.MapValueFrom(ctx, metalStorageDeviceType, devices)
+		diags.Append(d...)
+	}
+
+	if service.MetalDevice != nil {
+		device := service.MetalDevice
+		model := metalDeviceModel{
+			Name:           types.StringPointerValue(device.Name),
+			CPUModel:       types.StringPointerValue(device.CpuModel),
+			MemoryGB:       int32Value(device.MemoryGb),
+			MemoryModules:  types.ListNull(metalMemoryModuleType),
+			Serial:         types.StringPointerValue(device.Serial),
+			RackName:       types.StringPointerValue(device.RackName),
+			RackPosition:   types.Float64PointerValue(device.RackPosition),
+			RackFacilityID: types.StringPointerValue(device.RackFacilityId),
+			BMCIP:          types.StringPointerValue(device.BmcIp),
+		}
+
+		if device.MemoryModules != nil {
+			modules := []metalMemoryModuleModel{}
+			for _, module := range *device.MemoryModules {
+				modules = append(modules, metalMemoryModuleModel{
+					Name:              types.StringPointerValue(module.Name),
+					CapacityGB:        int32Value(module.CapacityGb),
+					Manufacturer:      types.StringPointerValue(module.Manufacturer),
+					MemoryType:        types.StringPointerValue(module.MemoryType),
+					OperatingSpeedMHz: int32Value(module.OperatingSpeedMhz),
+					PartNumber:        types.StringPointerValue(module.PartNumber),
+					SerialNumber:      types.StringPointerValue(module.SerialNumber),
+					Status:            types.StringPointerValue(module.Status),
+				})
+			}
+			model.MemoryModules, d = types.ListValueFrom(ctx, metalMemoryModuleType, modules)
+			diags.Append(d...)
+		}
+
+		hw.Device, d = types.ObjectValueFrom(ctx, metalDeviceType.AttrTypes, model)
+		diags.Append(d...)
+	}
+
+	return hw, diags
+}
+
+func int32Value(v *int32) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+// splitIPAddresses separates IPv4 from IPv6 addresses. Addresses may carry a
+// prefix length, which is kept. Unparseable addresses are dropped.
+func splitIPAddresses(addresses []string) (v4, v6 []string) {
+	v4, v6 = []string{}, []string{}
+	for _, s := range addresses {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			prefix, err := netip.ParsePrefix(s)
+			if err != nil {
+				continue
+			}
+			addr = prefix.Addr()
+		}
+
+		if addr.Unmap().Is4() {
+			v4 = append(v4, s)
+		} else {
+			v6 = append(v6, s)
+		}
+	}
+	return v4, v6
+}
+
+// metalHardwareAttribute describes a computed hardware attribute. The
+// teraswitch_metal resource and data source schemas are both built from
+// metalHardwareAttributes, so they stay in sync.
+type metalHardwareAttribute struct {
+	Description string
+	// Type is the attribute's type. Lists and maps of objects, and objects,
+	// are nested attributes whose attributes are described by Nested.
+	Type   attr.Type
+	Nested map[string]metalHardwareAttribute
+}
+
+var metalHardwareAttributes = map[string]metalHardwareAttribute{
+	"ipv4_addresses": {
+		Description: "The IPv4 addresses of the metal service.",
+		Type:        types.ListType{ElemType: types.StringType},
+	},
+	"ipv6_addresses": {
+		Description: "The IPv6 addresses of the metal service.",
+		Type:        types.ListType{ElemType: types.StringType},
+	},
+	"interfaces": {
+		Description: "The network interfaces of the metal service.",
+		Type:        types.ListType{ElemType: metalInterfaceType},
+		Nested: map[string]metalHardwareAttribute{
+			"name":        {Description: "The name of the interface.", Type: types.StringType},
+			"mac_address": {Description: "The MAC address of the interface.", Type: types.StringType},
+			"link_speed":  {Description: "The link speed of the interface in Mbps.", Type: types.Int64Type},
+		},
+	},
+	"storage_devices": {
+		Description: "The drives installed in the metal service, keyed by device name like `nvme0n1`.",
+		Type:        types.MapType{ElemType: metalStorageDeviceType},
+		Nested: map[string]metalHardwareAttribute{
+			"name":        {Description: "The drive option, like `1.92t`.", Type: types.StringType},
+			"capacity_gb": {Description: "The capacity of the drive in GB.", Type: types.Int64Type},
+			"type":        {Description: "The storage type of the drive, like `NVME`.", Type: types.StringType},
+		},
+	},
+	"device": {
+		Description: "The physical server backing the metal service.",
+		Type:        metalDeviceType,
+		Nested: map[string]metalHardwareAttribute{
+			"name":      {Description: "The name of the server.", Type: types.StringType},
+			"cpu_model": {Description: "The CPU model of the server.", Type: types.StringType},
+			"memory_gb": {Description: "The installed memory in GB.", Type: types.Int64Type},
+			"memory_modules": {
+				Description: "The installed memory modules.",
+				Type:        types.ListType{ElemType: metalMemoryModuleType},
+				Nested: map[string]metalHardwareAttribute{
+					"name":                {Description: "The name of the module slot.", Type: types.StringType},
+					"capacity_gb":         {Description: "The capacity of the module in GB.", Type: types.Int64Type},
+					"manufacturer":        {Description: "The manufacturer of the module.", Type: types.StringType},
+					"memory_type":         {Description: "The memory type, like `DDR5`.", Type: types.StringType},
+					"operating_speed_mhz": {Description: "The operating speed of the module in MHz.", Type: types.Int64Type},
+					"part_number":         {Description: "The part number of the module.", Type: types.StringType},
+					"serial_number":       {Description: "The serial number of the module.", Type: types.StringType},
+					"status":              {Description: "The status of the module.", Type: types.StringType},
+				},
+			},
+			"serial":           {Description: "The serial number of the server.", Type: types.StringType},
+			"rack_name":        {Description: "The name of the rack the server is in.", Type: types.StringType},
+			"rack_position":    {Description: "The position of the server in its rack.", Type: types.Float64Type},
+			"rack_facility_id": {Description: "The facility ID of the rack the server is in.", Type: types.StringType},
+			"bmc_ip":           {Description: "The IP address of the server's BMC.", Type: types.StringType},
+		},
+	},
+}
+
+// metalHardwareDataSourceAttributes returns the data source schema of the
+// given hardware attributes.
+func metalHardwareDataSourceAttributes(attributes map[string]metalHardwareAttribute) map[string]dsschema.Attribute {
+	result := make(map[string]dsschema.Attribute, len(attributes))
+	for name, a := range attributes {
+		switch t := a.Type.(type) {
+		case types.ListType:
+			if a.Nested != nil {
+				result[name] = dsschema.ListNestedAttribute{
+					MarkdownDescription: a.Description,
+					Computed:            true,
+					NestedObject:        dsschema.NestedAttributeObject{Attributes: metalHardwareDataSourceAttributes(a.Nested)},
+				}
+			} else {
+				result[name] = dsschema.ListAttribute{MarkdownDescription: a.Description, Computed: true, ElementType: t.ElemType}
+			}
+		case types.MapType:
+			result[name] = dsschema.MapNestedAttribute{
+				MarkdownDescription: a.Description,
+				Computed:            true,
+				NestedObject:        dsschema.NestedAttributeObject{Attributes: metalHardwareDataSourceAttributes(a.Nested)},
+			}
+		case types.ObjectType:
+			result[name] = dsschema.SingleNestedAttribute{
+				MarkdownDescription: a.Description,
+				Computed:            true,
+				Attributes:          metalHardwareDataSourceAttributes(a.Nested),
+			}
+		case basetypes.Int64Type:
+			result[name] = dsschema.Int64Attribute{MarkdownDescription: a.Description, Computed: true}
+		case basetypes.Float64Type:
+			result[name] = dsschema.Float64Attribute{MarkdownDescription: a.Description, Computed: true}
+		default:
+			result[name] = dsschema.StringAttribute{MarkdownDescription: a.Description, Computed: true}
+		}
+	}
+	return result
+}
+
+// metalHardwareResourceAttributes returns the resource schema of the given
+// hardware attributes. When keepState is set, the attributes keep their
+// values from state, unless ModifyPlan plans them as unknown because the
+// server is reinstalled.
+func metalHardwareResourceAttributes(attributes map[string]metalHardwareAttribute, keepState bool) map[string]rsschema.Attribute {
+	result := make(map[string]rsschema.Attribute, len(attributes))
+	for name, a := range attributes {
+		switch t := a.Type.(type) {
+		case types.ListType:
+			var modifiers []planmodifier.List
+			if keepState {
+				modifiers = []planmodifier.List{listplanmodifier.UseStateForUnknown()}
+			}
+
+			if a.Nested != nil {
+				result[name] = rsschema.ListNestedAttribute{
+					MarkdownDescription: a.Description,
+					Computed:            true,
+					NestedObject:        rsschema.NestedAttributeObject{Attributes: metalHardwareResourceAttributes(a.Nested, false)},
+					PlanModifiers:       modifiers,
+				}
+			} else {
+				result[name] = rsschema.ListAttribute{MarkdownDescription: a.Description, Computed: true, ElementType: t.ElemType, PlanModifiers: modifiers}
+			}
+		case types.MapType:
+			var modifiers []planmodifier.Map
+			if keepState {
+				modifiers = []planmodifier.Map{mapplanmodifier.UseStateForUnknown()}
+			}
+
+			result[name] = rsschema.MapNestedAttribute{
+				MarkdownDescription: a.Description,
+				Computed:            true,
+				NestedObject:        rsschema.NestedAttributeObject{Attributes: metalHardwareResourceAttributes(a.Nested, false)},
+				PlanModifiers:       modifiers,
+			}
+		case types.ObjectType:
+			var modifiers []planmodifier.Object
+			if keepState {
+				modifiers = []planmodifier.Object{objectplanmodifier.UseStateForUnknown()}
+			}
+
+			result[name] = rsschema.SingleNestedAttribute{
+				MarkdownDescription: a.Description,
+				Computed:            true,
+				Attributes:          metalHardwareResourceAttributes(a.Nested, false),
+				PlanModifiers:       modifiers,
+			}
+		case basetypes.Int64Type:
+			result[name] = rsschema.Int64Attribute{MarkdownDescription: a.Description, Computed: true}
+		case basetypes.Float64Type:
+			result[name] = rsschema.Float64Attribute{MarkdownDescription: a.Description, Computed: true}
+		default:
+			result[name] = rsschema.StringAttribute{MarkdownDescription: a.Description, Computed: true}
+		}
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitIPAddresses(t *testing.T) {
+	v4, v6 := splitIPAddresses([]string{
+		"203.0.113.10",
+		"2001:db8::10",
+		"198.51.100.0/29",
+		"2001:db8:1::/64",
+		"::ffff:192.0.2.1",
+		"not an address",
+	})
+
+	require.Equal(t, []string{"203.0.113.10", "198.51.100.0/29", "::ffff:192.0.2.1"}, v4)
+	require.Equal(t, []string{"2001:db8::10", "2001:db8:1::/64"}, v6)
+}
+
+func TestNewMetalHardware(t *testing.T) {
+	ctx := context.Background()
+
+	hw, diags := newMetalHardware(ctx, client.MetalService{
+		IpAddresses:        &[]string{"203.0.113.10", "2001:db8::10"},
+		Ipv4DefaultGateway: PtrTo("203.0.113.1"),
+		Interfaces: &[]client.Interface{
+			{Name: PtrTo("eno1"), MacAddress: PtrTo("00:00:5e:00:53:01"), LinkSpeed: PtrTo(int32(25000))},
+		},
+		StorageDevices: &map[string]client.MetalStorageDevice{
+			"nvme0n1": {Name: PtrTo("1.92t"), CapacityGb: PtrTo(int32(1920)), Type: PtrTo(client.StorageTypeNVME)},
+		},
+		MetalDevice: &client.MetalDevice{
+			CpuModel: PtrTo("AMD Ryzen 9 7950X"),
+			Serial:   PtrTo("SN123"),
+			MemoryModules: &[]client.MemoryModule{
+				{Name: PtrTo("DIMM_A1"), CapacityGb: PtrTo(int32(32))},
+			},
+		},
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	var v4 []string
+	require.False(t, hw.IPv4Addresses.ElementsAs(ctx, &v4, false).HasError())
+	require.Equal(t, []string{"203.0.113.10"}, v4)
+	require.Equal(t, "203.0.113.1", hw.IPv4DefaultGateway.ValueString())
+	require.True(t, hw.IPv6DefaultGateway.IsNull())
+
+	var interfaces []metalInterfaceModel
+	require.False(t, hw.Interfaces.ElementsAs(ctx, &interfaces, false).HasError())
+	require.Equal(t, "00:00:5e:00:53:01", interfaces[0].MACAddress.ValueString())
+	require.Equal(t, int64(25000), interfaces[0].LinkSpeed.ValueInt64())
+
+	var devices map[string]metalStorageDeviceModel
+	require.False(t, hw.StorageDevices.ElementsAs(ctx, &devices, false).HasError())
+	require.Equal(t, types.StringValue("NVME"), devices["nvme0n1"].Type)
+
+	var device metalDeviceModel
+	require.False(t, hw.Device.As(ctx, &device, basetypes.ObjectAsOptions{}).HasError())
+	require.Equal(t, "SN123", device.Serial.ValueString())
+	require.True(t, device.BMCIP.IsNull())
+	require.Len(t, device.MemoryModules.Elements(), 1)
+}
+
+func TestNewMetalHardware_empty(t *testing.T) {
+	hw, diags := newMetalHardware(context.Background(), client.MetalService{})
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, nullMetalHardware(), hw)
+}
+
+func TestMetalHardwareAttributes(t *testing.T) {
+	dataSource := metalHardwareDataSourceAttributes(metalHardwareAttributes)
+	resource := metalHardwareResourceAttributes(metalHardwareAttributes, true)
+
+	for name, a := range metalHardwareAttributes {
+		require.Truef(t, dataSource[name].GetType().Equal(a.Type), "data source %s has type %s", name, dataSource[name].GetType())
+		require.Truef(t, resource[name].GetType().Equal(a.Type), "resource %s has type %s", name, resource[name].GetType())
+	}
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetalLogsDataSource{}
+
+func NewMetalLogsDataSource() datasource.DataSource {
+	return &MetalLogsDataSource{}
+}
+
+// MetalLogsDataSource defines the data source implementation.
+type MetalLogsDataSource struct {
+	providerData *ProviderData
+}
+
+// MetalLogModel describes a single log line of a metal service.
+type MetalLogModel struct {
+	Name      types.String `tfsdk:"name"`
+	Message   types.String `tfsdk:"message"`
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+// MetalLogsDataSourceModel describes the data source data model.
+type MetalLogsDataSourceModel struct {
+	MetalID types.Int64     `tfsdk:"metal_id"`
+	Since   types.String    `tfsdk:"since"`
+	Name    types.String    `tfsdk:"name"`
+	Tail    types.Int64     `tfsdk:"tail"`
+	Logs    []MetalLogModel `tfsdk:"logs"`
+}
+
+func (d *MetalLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metal_logs"
+}
+
+func (d *MetalLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Metal logs data source allows you to retrieve the provisioning and cloud-init logs of a metal service, for example to assert in a `check` block that a build finished cleanly.",
+
+		Attributes: map[string]schema.Attribute{
+			"metal_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the metal service to read logs for.",
+				Required:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only include log lines logged at or after this RFC 3339 timestamp, like `2025-01-30T15:04:05Z`.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only include log lines with this name, like `cloud-init`. Matching is case-insensitive.",
+				Optional:            true,
+			},
+			"tail": schema.Int64Attribute{
+				MarkdownDescription: "Only include the last `tail` matching log lines.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"logs": schema.ListNestedAttribute{
+				MarkdownDescription: "Log lines matching the filters, oldest first. Lines whose timestamp can't be parsed are listed first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the log the line belongs to.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The logged message.",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "When the line was logged.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MetalLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *MetalLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetalLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := metalLogFilter{
+		name: data.Name.ValueString(),
+		tail: int(data.Tail.ValueInt64()),
+	}
+
+	if !data.Since.IsNull() {
+		since, err := time.Parse(time.RFC3339, data.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("since"), "Invalid Timestamp",
+				fmt.Sprintf("Expected since to be an RFC 3339 timestamp like \"2025-01-30T15:04:05Z\", got %q.", data.Since.ValueString()))
+			return
+		}
+		filter.since = &since
+	}
+
+	res, err := d.providerData.client.GetV2MetalIdLogsWithResponse(ctx, data.MetalID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metal logs, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("read metal logs", res.StatusCode(), res.Body, req.Config.Raw)...)
+		return
+	}
+
+	var logs []client.LogMessage
+	if res.JSON200 != nil && res.JSON200.Result != nil {
+		logs = *res.JSON200.Result
+	}
+
+	data.Logs = []MetalLogModel{}
+	for _, line := range filter.apply(ctx, logs) {
+		data.Logs = append(data.Logs, MetalLogModel{
+			Name:      types.StringPointerValue(line.Name),
+			Message:   types.StringPointerValue(line.Message),
+			Timestamp: types.StringPointerValue(line.Timestamp),
+		})
+	}
+
+	tflog.Trace(ctx, "read metal logs data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// metalLogFilter selects log lines by name and timestamp, keeping the last
+// tail lines in timestamp order. Zero values don't filter.
+type metalLogFilter struct {
+	since *time.Time
+	name  string
+	tail  int
+}
+
+// metalLogTimestampLayouts are the accepted log timestamp formats. Timestamps
+// without a zone are UTC.
+var metalLogTimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
+func (f metalLogFilter) apply(ctx context.Context, logs []client.LogMessage) []client.LogMessage {
+	var matched []client.LogMessage
+	for _, line := range logs {
+		if f.name != "" && !strings.EqualFold(derefOr(line.Name, ""), f.name) {
+			continue
+		}
+
+		if f.since != nil {
+			logged, ok := parseMetalLogTimestamp(derefOr(line.Timestamp, ""))
+			if !ok {
+				// Keep lines that can't be placed in time rather than hide them
+				tflog.Debug(ctx, "unable to parse metal log timestamp", map[string]interface{}{
+					"timestamp": derefOr(line.Timestamp, ""),
+				})
+			} else if logged.Before(*f.since) {
+				continue
+			}
+		}
+
+		matched = append(matched, line)
+	}
+
+	// The API doesn't guarantee the order of log lines. Lines whose timestamp
+	// can't be parsed sort first.
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, _ := parseMetalLogTimestamp(derefOr(matched[i].Timestamp, ""))
+		b, _ := parseMetalLogTimestamp(derefOr(matched[j].Timestamp, ""))
+		return a.Before(b)
+	})
+
+	if f.tail > 0 && len(matched) > f.tail {
+		matched = matched[len(matched)-f.tail:]
+	}
+
+	return matched
+}
+
+func parseMetalLogTimestamp(s string) (time.Time, bool) {
+	for _, layout := range metalLogTimestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
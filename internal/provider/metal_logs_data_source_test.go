@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetalLogFilter(t *testing.T) {
+	line := func(name, timestamp, message string) client.LogMessage {
+		return client.LogMessage{Name: PtrTo(name), Timestamp: PtrTo(timestamp), Message: PtrTo(message)}
+	}
+	logs := []client.LogMessage{
+		line("provision", "2025-01-30T15:00:00Z", "one"),
+		line("provision", "2025-01-30T15:04:00+00:00", "five"),
+		line("cloud-init", "2025-01-30T15:01:00.5Z", "two"),
+		line("cloud-init", "2025-01-30T15:02:00", "three"),
+		line("Cloud-Init", "garbage", "four"),
+	}
+	since := time.Date(2025, 1, 30, 15, 1, 30, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter metalLogFilter
+		want   []string
+	}{
+		{"sorted by timestamp", metalLogFilter{}, []string{"four", "one", "two", "three", "five"}},
+		{"name is case-insensitive", metalLogFilter{name: "cloud-init"}, []string{"four", "two", "three"}},
+		{"since keeps unparseable", metalLogFilter{since: &since}, []string{"four", "three", "five"}},
+		{"tail", metalLogFilter{tail: 2}, []string{"three", "five"}},
+		{"tail larger than logs", metalLogFilter{tail: 10}, []string{"four", "one", "two", "three", "five"}},
+		{"combined", metalLogFilter{name: "cloud-init", since: &since, tail: 1}, []string{"three"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, l := range tt.filter.apply(context.Background(), logs) {
+				got = append(got, *l.Message)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAccMetalLogsDataSource(t *testing.T) {
+	if os.Getenv("CI") != "" {
+		t.Skip("Skipping, metal tests are not run in CI")
+		return
+	}
+
+	metalID := "12345"
+	if envMetalID := os.Getenv("TERASWITCH_TEST_METAL_ID"); envMetalID != "" {
+		metalID = envMetalID
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "teraswitch" {}
+
+data "teraswitch_metal_logs" "test" {
+  metal_id = %s
+  tail     = 5
+}
+`, metalID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal_logs.test", "logs.#"),
+				),
+			},
+		},
+	})
+}
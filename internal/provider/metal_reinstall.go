@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// metalReinstallAttributes are the attributes that reinstall the server in
+// place when reinstall_on_change is enabled, and replace it otherwise.
+var metalReinstallAttributes = []string{
+	"image_id", "ssh_key_ids", "password", "user_data", "partitions", "raid_arrays", "memory_gb",
+}
+
+const metalReinstallDescription = "Reinstalls the server in place if reinstall_on_change is enabled, otherwise requires replacement. A value that isn't in state yet after an import is adopted instead."
+
+// reinstallOnChange reports whether the planned server is reinstalled rather
+// than replaced when one of metalReinstallAttributes changes.
+func reinstallOnChange(ctx context.Context, plan tfsdk.Plan) (bool, diag.Diagnostics) {
+	var reinstall types.Bool
+	diags := plan.GetAttribute(ctx, path.Root("reinstall_on_change"), &reinstall)
+
+	// Unknown until applied means the default is planned.
+	if reinstall.IsNull() || reinstall.IsUnknown() {
+		return true, diags
+	}
+
+	return reinstall.ValueBool(), diags
+}
+
+// reinstallChanged reports whether the plan changes one of
+// metalReinstallAttributes. When the server was just imported, values that
+// aren't in state yet are adopted without reinstalling.
+func reinstallChanged(plan, state tftypes.Value, imported bool) bool {
+	for _, name := range metalReinstallAttributes {
+		prior, _, err := tftypes.WalkAttributePath(state, tftypes.NewAttributePath().WithAttributeName(name))
+		if value, ok := prior.(tftypes.Value); imported && err == nil && ok && value.IsNull() {
+			continue
+		}
+
+		if attributesChanged(plan, state, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func requiresReplaceUnlessReinstallString() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			reinstall, diags := reinstallOnChange(ctx, req.Plan)
+			resp.Diagnostics.Append(diags...)
+			adopt, diags := adoptsImportedValue(ctx, req.Private, req.StateValue)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !reinstall && !adopt
+		},
+		metalReinstallDescription,
+		metalReinstallDescription,
+	)
+}
+
+func requiresReplaceUnlessReinstallInt64() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			reinstall, diags := reinstallOnChange(ctx, req.Plan)
+			resp.Diagnostics.Append(diags...)
+			adopt, diags := adoptsImportedValue(ctx, req.Private, req.StateValue)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !reinstall && !adopt
+		},
+		metalReinstallDescription,
+		metalReinstallDescription,
+	)
+}
+
+func requiresReplaceUnlessReinstallList() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			reinstall, diags := reinstallOnChange(ctx, req.Plan)
+			resp.Diagnostics.Append(diags...)
+			adopt, diags := adoptsImportedValue(ctx, req.Private, req.StateValue)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !reinstall && !adopt
+		},
+		metalReinstallDescription,
+		metalReinstallDescription,
+	)
+}
+
+// reinstall redeploys the server with the planned image, access and storage
+// configuration. The server keeps its hardware and IP addresses. planned is
+// the raw plan, which API validation failures are attached to.
+func (r *MetalResource) reinstall(ctx context.Context, id int64, plan MetalResourceModel, planned tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	body := client.ReinstallMetalRequest{
+		ProjectId:   plan.ProjectID.ValueInt64Pointer(),
+		RegionId:    plan.RegionID.ValueString(),
+		TierId:      plan.TierID.ValueString(),
+		DisplayName: plan.DisplayName.ValueStringPointer(),
+		ImageId:     plan.ImageID.ValueStringPointer(),
+		Password:    plan.Password.ValueStringPointer(),
+		UserData:    plan.UserData.ValueStringPointer(),
+		MemoryGb:    i64PtrToi32Ptr(plan.MemoryGB.ValueInt64Pointer()),
+		IpxeUrl:     plan.IPXEURL.ValueStringPointer(),
+		Partitions:  metalPartitionsBody(plan.Partitions),
+	}
+
+	diags.Append(plan.SSHKeyIDs.ElementsAs(ctx, &body.SshKeyIds, false)...)
+	diags.Append(plan.Disks.ElementsAs(ctx, &body.Disks, false)...)
+
+	var d diag.Diagnostics
+	body.RaidArrays, d = metalRaidArraysBody(ctx, plan.RaidArrays)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	res, err := r.providerData.client.PostV2MetalIdReinstallWithResponse(ctx, id, body)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to reinstall v2 metal, got error: %s", err))
+		return diags
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		diags.Append(apiErrorDiagnostics("reinstall metal service", res.StatusCode(), res.Body, planned)...)
+		return diags
+	}
+
+	return diags
+}
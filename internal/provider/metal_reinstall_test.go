@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReinstallChanged(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}
+	for _, name := range metalReinstallAttributes {
+		objectType.AttributeTypes[name] = tftypes.String
+	}
+
+	value := func(values map[string]string) tftypes.Value {
+		attributes := map[string]tftypes.Value{}
+		for _, name := range metalReinstallAttributes {
+			attributes[name] = tftypes.NewValue(tftypes.String, nil)
+			if v, ok := values[name]; ok {
+				attributes[name] = tftypes.NewValue(tftypes.String, v)
+			}
+		}
+		return tftypes.NewValue(objectType, attributes)
+	}
+
+	tests := []struct {
+		name        string
+		state, plan map[string]string
+		imported    bool
+		want        bool
+	}{
+		{"unchanged", map[string]string{"image_id": "ubuntu-noble"}, map[string]string{"image_id": "ubuntu-noble"}, false, false},
+		{"changed", map[string]string{"image_id": "ubuntu-noble"}, map[string]string{"image_id": "debian-12"}, false, true},
+		{"removed", map[string]string{"user_data": "#cloud-config"}, map[string]string{}, false, true},
+		{"added", map[string]string{}, map[string]string{"user_data": "#cloud-config"}, false, true},
+		{"adopted after import", map[string]string{}, map[string]string{"user_data": "#cloud-config", "password": "hunter2"}, true, false},
+		{"changed after import", map[string]string{"image_id": "ubuntu-noble"}, map[string]string{"image_id": "debian-12"}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reinstallChanged(value(tt.plan), value(tt.state), tt.imported); got != tt.want {
+				t.Fatalf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
@@ -1,43 +1,55 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
 	"math"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MetalResource{}
 var _ resource.ResourceWithImportState = &MetalResource{}
+var _ resource.ResourceWithValidateConfig = &MetalResource{}
+var _ resource.ResourceWithModifyPlan = &MetalResource{}
 
 func NewMetalResource() resource.Resource {
 	return &MetalResource{}
 }
 
+// metalTimeouts are the default deadlines of the resource's operations.
+var metalTimeouts = resourceTimeouts{
+	Create: 30 * time.Minute,
+	Update: 30 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
 // MetalResource defines the resource implementation.
 type MetalResource struct {
 	providerData *ProviderData
@@ -45,26 +57,59 @@ type MetalResource struct {
 
 // MetalResourceModel describes the resource data model.
 type MetalResourceModel struct {
-	ID                types.Int64           `tfsdk:"id"`
-	ProjectID         types.Int64           `tfsdk:"project_id"`
-	RegionID          types.String          `tfsdk:"region_id"`
-	DisplayName       types.String          `tfsdk:"display_name"`
-	TierID            types.String          `tfsdk:"tier_id"`
-	ImageID           types.String          `tfsdk:"image_id"`
-	SSHKeyIDs         types.List            `tfsdk:"ssh_key_ids"`
-	Password          types.String          `tfsdk:"password"`
-	UserData          types.String          `tfsdk:"user_data"`
-	Tags              types.List            `tfsdk:"tags"`
-	MemoryGB          types.Int64           `tfsdk:"memory_gb"`
-	Disks             types.Map             `tfsdk:"disks"`
-	Partitions        []MetalPartitionModel `tfsdk:"partitions"`
-	RaidArrays        []MetalRaidArrayModel `tfsdk:"raid_arrays"`
-	IPXEURL           types.String          `tfsdk:"ipxe_url"`
-	TemplateID        types.Int64           `tfsdk:"template_id"`
-	ReservePricing    types.Bool            `tfsdk:"reserve_pricing"`
-	IPAddresses       types.List            `tfsdk:"ip_addresses"`
-	DesiredPowerState types.String          `tfsdk:"desired_power_state"`
-	WaitForReady      types.Bool            `tfsdk:"wait_for_ready"`
+	ID                 types.Int64           `tfsdk:"id"`
+	ProjectID          types.Int64           `tfsdk:"project_id"`
+	RegionID           types.String          `tfsdk:"region_id"`
+	DisplayName        types.String          `tfsdk:"display_name"`
+	TierID             types.String          `tfsdk:"tier_id"`
+	ImageID            types.String          `tfsdk:"image_id"`
+	SSHKeyIDs          types.List            `tfsdk:"ssh_key_ids"`
+	Password           types.String          `tfsdk:"password"`
+	UserData           types.String          `tfsdk:"user_data"`
+	Tags               types.List            `tfsdk:"tags"`
+	MemoryGB           types.Int64           `tfsdk:"memory_gb"`
+	Disks              types.Map             `tfsdk:"disks"`
+	Partitions         []MetalPartitionModel `tfsdk:"partitions"`
+	RaidArrays         []MetalRaidArrayModel `tfsdk:"raid_arrays"`
+	IPXEURL            types.String          `tfsdk:"ipxe_url"`
+	TemplateID         types.Int64           `tfsdk:"template_id"`
+	ReservePricing     types.Bool            `tfsdk:"reserve_pricing"`
+	IPAddresses        types.List            `tfsdk:"ip_addresses"`
+	IPv4Addresses      types.List            `tfsdk:"ipv4_addresses"`
+	IPv6Addresses      types.List            `tfsdk:"ipv6_addresses"`
+	IPv4DefaultGateway types.String          `tfsdk:"ipv4_default_gateway"`
+	IPv6DefaultGateway types.String          `tfsdk:"ipv6_default_gateway"`
+	Interfaces         types.List            `tfsdk:"interfaces"`
+	StorageDevices     types.Map             `tfsdk:"storage_devices"`
+	Device             types.Object          `tfsdk:"device"`
+	DesiredPowerState  types.String          `tfsdk:"desired_power_state"`
+	WaitForReady       types.Bool            `tfsdk:"wait_for_ready"`
+	ReinstallOnChange  types.Bool            `tfsdk:"reinstall_on_change"`
+	EstimatedHourly    types.Float64         `tfsdk:"estimated_hourly_price"`
+	EstimatedMonthly   types.Float64         `tfsdk:"estimated_monthly_price"`
+	Timeouts           timeouts.Value        `tfsdk:"timeouts"`
+}
+
+func (m *MetalResourceModel) hardware() metalHardware {
+	return metalHardware{
+		IPv4Addresses:      m.IPv4Addresses,
+		IPv6Addresses:      m.IPv6Addresses,
+		IPv4DefaultGateway: m.IPv4DefaultGateway,
+		IPv6DefaultGateway: m.IPv6DefaultGateway,
+		Interfaces:         m.Interfaces,
+		StorageDevices:     m.StorageDevices,
+		Device:             m.Device,
+	}
+}
+
+func (m *MetalResourceModel) setHardware(hw metalHardware) {
+	m.IPv4Addresses = hw.IPv4Addresses
+	m.IPv6Addresses = hw.IPv6Addresses
+	m.IPv4DefaultGateway = hw.IPv4DefaultGateway
+	m.IPv6DefaultGateway = hw.IPv6DefaultGateway
+	m.Interfaces = hw.Interfaces
+	m.StorageDevices = hw.StorageDevices
+	m.Device = hw.Device
 }
 
 type MetalRaidArrayModel struct {
@@ -148,18 +193,21 @@ func (r *MetalResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 			},
 			"tier_id": schema.StringAttribute{
-				MarkdownDescription: "The service tier to be created. For metal, this is typically the server config. For example: 7302p-64g would create a Epyc 7302P system with 64G of ram. Tier availability can be retrieved using the regions endpoints.",
-				Required:            true,
+				MarkdownDescription: "The service tier to be created. For metal, this is typically the server config. For example: 7302p-64g would create a Epyc 7302P system with 64G of ram. Tier availability can be retrieved using the regions endpoints. Required unless `template_id` is set, in which case it defaults to the template's tier.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					useStateForTemplateDefault(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"image_id": schema.StringAttribute{
-				MarkdownDescription: "The image to use when creating this service. Available images can be retrieved via the images endpoint.",
+				MarkdownDescription: "The image to use when creating this service. Available images can be retrieved via the images endpoint. Defaults to the template's value when `template_id` is set.",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					// TODO: allow updating
-					stringplanmodifier.RequiresReplace(),
+					useStateForTemplateDefault(),
+					requiresReplaceUnlessReinstallString(),
 				},
 			},
 			"ssh_key_ids": schema.ListAttribute{
@@ -167,27 +215,27 @@ func (r *MetalResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				ElementType:         types.Int64Type,
 				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessReinstallList(),
 				},
 				Validators: []validator.List{
-					listvalidator.AtLeastOneOf(path.MatchRoot("password")),
+					listvalidator.AtLeastOneOf(path.MatchRoot("password"), path.MatchRoot("template_id")),
 				},
 			},
 			"password": schema.StringAttribute{
 				MarkdownDescription: "The password to be set for the root user. If not provided, a random password will be generated.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessReinstallString(),
 				},
 				Validators: []validator.String{
-					stringvalidator.AtLeastOneOf(path.MatchRoot("ssh_key_ids")),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("ssh_key_ids"), path.MatchRoot("template_id")),
 				},
 			},
 			"user_data": schema.StringAttribute{
 				MarkdownDescription: "Additional user data.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessReinstallString(),
 				},
 			},
 			"tags": schema.ListAttribute{
@@ -196,23 +244,28 @@ func (r *MetalResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				ElementType:         types.StringType,
 			},
 			"memory_gb": schema.Int64Attribute{
-				MarkdownDescription: "The amount of memory in GB to be allocated to the metal service.",
+				MarkdownDescription: "The amount of memory in GB to be allocated to the metal service. Defaults to the template's value when `template_id` is set.",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					useStateForTemplateDefault(),
+					requiresReplaceUnlessReinstallInt64(),
 				},
 			},
 			"disks": schema.MapAttribute{
-				MarkdownDescription: "Dictionary of disk names and sizes in GB. If not specified, the default configuration for the metal tier will be used. The key is the disk name and the value is the size in GB.",
+				MarkdownDescription: "Dictionary of disk names and sizes in GB. If not specified, the default configuration for the metal tier will be used. The key is the disk name and the value is the size in GB. Defaults to the template's value when `template_id` is set.",
 				Optional:            true,
+				Computed:            true,
 				ElementType:         types.StringType,
 				PlanModifiers: []planmodifier.Map{
+					useStateForTemplateDefault(),
 					mapplanmodifier.RequiresReplace(),
 				},
 			},
 			"partitions": schema.ListNestedAttribute{
-				MarkdownDescription: "Partitions to be created on the metal service. Not specifying this will result in a single root partition being created.",
+				MarkdownDescription: "Partitions to be created on the metal service. Not specifying this will result in a single root partition being created. Defaults to the template's value when `template_id` is set.",
 				Optional:            true,
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
@@ -239,12 +292,14 @@ func (r *MetalResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+					useStateForTemplateDefault(),
+					requiresReplaceUnlessReinstallList(),
 				},
 			},
 			"raid_arrays": schema.ListNestedAttribute{
-				MarkdownDescription: "Raid arrays to be created on the metal service. Can reference physical device names or partitions from mediums of the same class.",
+				MarkdownDescription: "Raid arrays to be created on the metal service. Can reference physical device names or partitions from mediums of the same class. Defaults to the template's value when `template_id` is set.",
 				Optional:            true,
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
@@ -284,7 +339,8 @@ func (r *MetalResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+					useStateForTemplateDefault(),
+					requiresReplaceUnlessReinstallList(),
 				},
 			},
 			"ipxe_url": schema.StringAttribute{
@@ -295,7 +351,7 @@ func (r *MetalResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"template_id": schema.Int64Attribute{
-				MarkdownDescription: "Template can be specified instead of image, partitions, sshKeyId, and userData.",
+				MarkdownDescription: "Template can be specified instead of image, partitions, sshKeyId, and userData. Unset `tier_id`, `image_id`, `memory_gb`, `disks`, `partitions` and `raid_arrays` are planned from the template, see the `teraswitch_metal_templates` data source.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
@@ -308,12 +364,31 @@ func (r *MetalResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
-
+			"reinstall_on_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether a change to `image_id`, `ssh_key_ids`, `password`, `user_data`, `partitions`, `raid_arrays` or `memory_gb` reinstalls the server in place, keeping its hardware and IP addresses, and waits for it to become active again. The server's disks are erased either way. When `false` the server is replaced instead. On the first apply after an import, values that aren't in state yet are recorded without reinstalling or replacing the server. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 			"ip_addresses": schema.ListAttribute{
 				MarkdownDescription: "IP addresses of the metal instance.",
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"ipv4_default_gateway": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 default gateway for the metal service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv6_default_gateway": schema.StringAttribute{
+				MarkdownDescription: "The IPv6 default gateway for the metal service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"desired_power_state": schema.StringAttribute{
 				MarkdownDescription: "The desired power state for the metal instance.",
 				Optional:            true,
@@ -329,8 +404,30 @@ func (r *MetalResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"estimated_hourly_price": schema.Float64Attribute{
+				MarkdownDescription: "The estimated hourly price of the configured tier, memory and disks, calculated when the server is planned. Null if the price could not be calculated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"estimated_monthly_price": schema.Float64Attribute{
+				MarkdownDescription: "The estimated monthly price of the configured tier, memory and disks, calculated when the server is planned. Null if the price could not be calculated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, metalTimeouts),
 		},
 	}
+
+	for name, attribute := range metalHardwareResourceAttributes(metalHardwareAttributes, true) {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (r *MetalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -352,6 +449,260 @@ func (r *MetalResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.providerData = client
 }
 
+func (r *MetalResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tierID types.String
+	var templateID types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tier_id"), &tierID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	if tierID.IsNull() && templateID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("tier_id"), "Missing Tier",
+			"tier_id is required unless the server is deployed from a template with template_id.")
+	}
+
+	storage, diags := readMetalStorage(ctx, req.Config.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without explicit disks the tier's default drives are used; device
+	// references are checked against those in ModifyPlan.
+	var diskNames map[string]bool
+	if storage.DisksKnown && storage.Disks != nil {
+		diskNames = make(map[string]bool, len(storage.Disks))
+		for name := range storage.Disks {
+			diskNames[name] = true
+		}
+	}
+
+	resp.Diagnostics.Append(storage.validate(ctx, diskNames)...)
+}
+
+func (r *MetalResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	resuming, diags := planResumeProvisioning(ctx, req, resp)
+	resp.Diagnostics.Append(diags...)
+	if resuming {
+		resp.Diagnostics.Append(planUnknownHardware(ctx, resp)...)
+	}
+
+	// The checks below see the values planned from the template
+	resp.Diagnostics.Append(r.applyTemplate(ctx, req, resp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	req.Plan = resp.Plan
+
+	// Region and tier force replacement, so a change to either deploys a new server.
+	if req.State.Raw.IsNull() || planChanged(req, "region_id", "tier_id") {
+		resp.Diagnostics.Append(r.checkStock(ctx, req)...)
+	}
+
+	if req.State.Raw.IsNull() || planChanged(req, "region_id", "tier_id", "memory_gb", "disks", "reserve_pricing") {
+		resp.Diagnostics.Append(r.estimatePrice(ctx, req, resp)...)
+	}
+
+	reinstall, diags := reinstallOnChange(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	wasImported, diags := imported(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if reinstall && !req.State.Raw.IsNull() && reinstallChanged(req.Plan.Raw, req.State.Raw, wasImported) {
+		resp.Diagnostics.AddWarning(
+			"Metal Server Will Be Reinstalled",
+			"This plan reinstalls the server in place, which erases its disks. Set reinstall_on_change to false to replace the server instead.",
+		)
+		resp.Diagnostics.Append(planUnknownHardware(ctx, resp)...)
+	}
+
+	resp.Diagnostics.Append(checkPlannedSpend(ctx, r.providerData.spendGuard, "teraswitch_metal", req, resp)...)
+
+	if req.State.Raw.IsNull() || planChanged(req, "tier_id", "image_id", "disks", "partitions", "raid_arrays") {
+		resp.Diagnostics.Append(r.validateStoragePlan(ctx, req)...)
+	}
+}
+
+// estimatePrice fills in the planned price estimates.
+func (r *MetalResource) estimatePrice(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var regionID, tierID types.String
+	var memoryGB types.Int64
+	var disks types.Map
+	var reserved types.Bool
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("region_id"), &regionID)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("tier_id"), &tierID)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("memory_gb"), &memoryGB)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("disks"), &disks)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("reserve_pricing"), &reserved)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var body *client.CalculatePriceRequest
+	if !regionID.IsUnknown() && !tierID.IsUnknown() && !memoryGB.IsUnknown() && !disks.IsUnknown() && !reserved.IsUnknown() {
+		body = &client.CalculatePriceRequest{
+			ServiceType: PtrTo(serviceTypeMetal),
+			RegionId:    regionID.ValueStringPointer(),
+			TierId:      tierID.ValueStringPointer(),
+			Reserved:    reserved.ValueBoolPointer(),
+		}
+		if !memoryGB.IsNull() {
+			body.MemoryGb = PtrTo(float64(memoryGB.ValueInt64()))
+		}
+		diags.Append(disks.ElementsAs(ctx, &body.Disks, false)...)
+	}
+
+	_, d := planPriceEstimate(ctx, r.providerData.client, &resp.Plan, body)
+	diags.Append(d...)
+
+	return diags
+}
+
+// checkStock fails the plan if the tier is sold out in the planned region,
+// suggesting regions where it is in stock. Lookup failures are logged and
+// skip the check.
+func (r *MetalResource) checkStock(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var regionID, tierID types.String
+	var projectID types.Int64
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("region_id"), &regionID)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("tier_id"), &tierID)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	if diags.HasError() || regionID.IsUnknown() || tierID.IsUnknown() || projectID.IsUnknown() {
+		return diags
+	}
+
+	project := r.providerData.projectID
+	if !projectID.IsNull() {
+		project = projectID.ValueInt64()
+	}
+	if project == 0 {
+		return diags
+	}
+
+	availability, err := readMetalAvailability(ctx, r.providerData.client, project, nil)
+	if err != nil {
+		tflog.Warn(ctx, "skipping stock check, unable to read metal availability", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return diags
+	}
+
+	region, tier := regionID.ValueString(), tierID.ValueString()
+	if availability[region][tier] > 0 {
+		return diags
+	}
+
+	var inStock []string
+	listed := false
+	for _, other := range sortedKeys(availability) {
+		quantity, ok := availability[other][tier]
+		listed = listed || ok
+		if other != region && quantity > 0 {
+			inStock = append(inStock, fmt.Sprintf("%s (%d available)", other, quantity))
+		}
+	}
+
+	// The API doesn't report every tier; don't block ones it doesn't know about.
+	if !listed {
+		tflog.Warn(ctx, "skipping stock check, tier not listed in metal availability", map[string]interface{}{
+			"tier_id": tier,
+		})
+		return diags
+	}
+
+	suggestion := "It is not in stock in any other region."
+	if len(inStock) > 0 {
+		suggestion = "It is in stock in: " + strings.Join(inStock, ", ") + "."
+	}
+
+	diags.AddAttributeError(path.Root("region_id"), "Metal Tier Out of Stock",
+		fmt.Sprintf("Tier %s is sold out in %s. %s", tier, region, suggestion))
+
+	return diags
+}
+
+// validateStoragePlan checks the planned storage layout against the tier's
+// drive slots and the image. Lookup failures are logged and skip the checks
+// rather than failing the plan.
+func (r *MetalResource) validateStoragePlan(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	storage, d := readMetalStorage(ctx, req.Plan.GetAttribute)
+	diags.Append(d...)
+
+	var tierID, imageID types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("tier_id"), &tierID)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("image_id"), &imageID)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if storage.DisksKnown && storage.PartitionsKnown && storage.RaidArraysKnown && !tierID.IsUnknown() {
+		tier, err := findMetalTier(ctx, r.providerData.client, tierID.ValueString())
+		switch {
+		case err != nil:
+			tflog.Warn(ctx, "skipping storage capacity checks, unable to read metal tiers", map[string]interface{}{
+				"error": err.Error(),
+			})
+		case tier != nil:
+			diskNames, d := storage.validateCapacity(*tier)
+			diags.Append(d...)
+			diags.Append(storage.validate(ctx, diskNames)...)
+		}
+	}
+
+	if storage.customized() && !imageID.IsUnknown() && !imageID.IsNull() {
+		image, err := findImage(ctx, r.providerData.client, imageID.ValueString())
+		switch {
+		case err != nil:
+			tflog.Warn(ctx, "skipping image storage check, unable to read images", map[string]interface{}{
+				"error": err.Error(),
+			})
+		case image != nil && derefOr(image.DisableCustomizableStorage, false):
+			diags.AddAttributeError(path.Root("image_id"), "Image Does Not Support Custom Storage",
+				fmt.Sprintf("Image %s does not allow custom partitions or RAID arrays. "+
+					"Remove partitions and raid_arrays, or choose an image that supports them.", imageID.ValueString()))
+		}
+	}
+
+	return diags
+}
+
+// planChanged reports whether any of the named top-level attributes differ
+// between the prior state and the plan.
+func planChanged(req resource.ModifyPlanRequest, names ...string) bool {
+	return attributesChanged(req.Plan.Raw, req.State.Raw, names...)
+}
+
+// attributesChanged reports whether any of the named top-level attributes
+// differ between the planned and prior values.
+func attributesChanged(plan, state tftypes.Value, names ...string) bool {
+	for _, name := range names {
+		p := tftypes.NewAttributePath().WithAttributeName(name)
+		planned, _, err := tftypes.WalkAttributePath(plan, p)
+		if err != nil {
+			return true
+		}
+		prior, _, err := tftypes.WalkAttributePath(state, p)
+		if err != nil {
+			return true
+		}
+		plannedValue, ok1 := planned.(tftypes.Value)
+		priorValue, ok2 := prior.(tftypes.Value)
+		if !ok1 || !ok2 || !plannedValue.Equal(priorValue) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *MetalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data MetalResourceModel
 
@@ -362,11 +713,33 @@ func (r *MetalResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, metalTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	projID := data.ProjectID.ValueInt64Pointer()
 	if projID == nil {
 		data.ProjectID = types.Int64Value(r.providerData.projectID)
 	}
 
+	// Values that couldn't be planned from the template are read back from
+	// the created server
+	tierUnknown, imageUnknown, memoryUnknown := data.TierID.IsUnknown(), data.ImageID.IsUnknown(), data.MemoryGB.IsUnknown()
+	if tierUnknown {
+		data.TierID = types.StringNull()
+	}
+	if imageUnknown {
+		data.ImageID = types.StringNull()
+	}
+	if memoryUnknown {
+		data.MemoryGB = types.Int64Null()
+	}
+
 	body := client.CreateMetalRequest{
 		ProjectId:      data.ProjectID.ValueInt64Pointer(),
 		RegionId:       data.RegionID.ValueString(),
@@ -392,38 +765,10 @@ func (r *MetalResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.Disks.ElementsAs(ctx, &body.Disks, false)...,
 	)
 
-	if len(data.Partitions) > 0 {
-		var parts []client.Partition
-		for _, dPart := range data.Partitions {
-			part := client.Partition{
-				Name:       dPart.Name.ValueStringPointer(),
-				Device:     dPart.Device.ValueStringPointer(),
-				SizeBytes:  dPart.SizeBytes.ValueInt64Pointer(),
-				FileSystem: PtrTo(client.FileSystem(dPart.FileSystem.ValueString())),
-				MountPoint: dPart.MountPoint.ValueStringPointer(),
-			}
-			parts = append(parts, part)
-		}
-		body.Partitions = &parts
-	}
+	body.Partitions = metalPartitionsBody(data.Partitions)
 
-	if len(data.RaidArrays) > 0 {
-		var arrays []client.RaidArray
-		for _, dRaid := range data.RaidArrays {
-			arr := client.RaidArray{
-				FileSystem: PtrTo(client.FileSystem(dRaid.FileSystem.ValueString())),
-				MountPoint: dRaid.MountPoint.ValueStringPointer(),
-				Name:       dRaid.Name.ValueStringPointer(),
-				SizeBytes:  dRaid.SizeBytes.ValueInt64Pointer(),
-				Type:       PtrTo(client.RaidType(dRaid.Type.ValueString())),
-			}
-			resp.Diagnostics.Append(
-				dRaid.Members.ElementsAs(ctx, &arr.Members, false)...,
-			)
-			arrays = append(arrays, arr)
-		}
-		body.RaidArrays = &arrays
-	}
+	body.RaidArrays, diags = metalRaidArraysBody(ctx, data.RaidArrays)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -436,9 +781,7 @@ func (r *MetalResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create get v2 metal, got error: %s", string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("create metal service", res.StatusCode(), res.Body, req.Plan.Raw)...)
 		return
 	}
 
@@ -447,24 +790,66 @@ func (r *MetalResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	resBody := res.JSON200.Result
+	if resBody.Id == nil {
+		resp.Diagnostics.AddError("Client Error",
+			"Unable to create v2 metal: the API accepted the request but returned no server ID, so the server can't be tracked. "+
+				"Check the TeraSwitch console for a new server before applying again.")
+		return
+	}
+	data.ID = types.Int64Value(*resBody.Id)
+
+	if tierUnknown {
+		data.TierID = types.StringPointerValue(resBody.TierId)
+	}
+	if imageUnknown {
+		data.ImageID = types.StringPointerValue(resBody.ImageId)
+	}
+	if memoryUnknown && resBody.MemoryGb != nil {
+		data.MemoryGB = types.Int64Value(int64(*resBody.MemoryGb))
+	}
+
+	// Computed values must be known once the server is in state, even if
+	// provisioning doesn't finish
+	if data.IPAddresses.IsUnknown() {
+		data.IPAddresses = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+	hardware, diags := newMetalHardware(ctx, *resBody)
+	resp.Diagnostics.Append(diags...)
+	data.setHardware(hardware)
+	resolvePriceEstimate(&data.EstimatedHourly, &data.EstimatedMonthly)
 
 	if data.WaitForReady.ValueBool() {
+		// Track the server before waiting so it isn't leaked if waiting
+		// fails. Terraform taints it because of the error, so the next apply
+		// replaces it.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(markProvisioningIncomplete(ctx, resp.Private)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		final, err := r.waitInstanceReady(ctx, *resBody.Id)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error",
-				fmt.Sprintf("Unable to wait v2 metal instance ready, got error: %s", err),
+				fmt.Sprintf("Unable to wait v2 metal instance ready, got error: %s\n\n"+
+					"Metal server %d was created and has been saved to state as tainted, so the next apply will replace it. "+
+					"To keep the server instead, run `terraform untaint` and the next apply will resume waiting for it.",
+					err, *resBody.Id),
 			)
 			return
 		}
 
 		if final.IpAddresses != nil {
-			var diags diag.Diagnostics
 			data.IPAddresses, diags = types.ListValueFrom(ctx, types.StringType, *final.IpAddresses)
 			resp.Diagnostics.Append(diags...)
 		}
-	}
 
-	data.ID = types.Int64Value(*resBody.Id)
+		hardware, diags = newMetalHardware(ctx, *final)
+		resp.Diagnostics.Append(diags...)
+		data.setHardware(hardware)
+
+		resp.Diagnostics.Append(clearProvisioningIncomplete(ctx, resp.Private)...)
+	}
 
 	tflog.Trace(ctx, "created v2 metal")
 
@@ -472,15 +857,81 @@ func (r *MetalResource) Create(ctx context.Context, req resource.CreateRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// metalPartitionsBody converts the configured partitions to their API
+// representation, or nil if there are none.
+func metalPartitionsBody(partitions []MetalPartitionModel) *[]client.Partition {
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	var parts []client.Partition
+	for _, dPart := range partitions {
+		part := client.Partition{
+			Name:       dPart.Name.ValueStringPointer(),
+			Device:     dPart.Device.ValueStringPointer(),
+			SizeBytes:  dPart.SizeBytes.ValueInt64Pointer(),
+			FileSystem: PtrTo(client.FileSystem(dPart.FileSystem.ValueString())),
+			MountPoint: dPart.MountPoint.ValueStringPointer(),
+		}
+		parts = append(parts, part)
+	}
+
+	return &parts
+}
+
+// metalRaidArraysBody converts the configured RAID arrays to their API
+// representation, or nil if there are none.
+func metalRaidArraysBody(ctx context.Context, raidArrays []MetalRaidArrayModel) (*[]client.RaidArray, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(raidArrays) == 0 {
+		return nil, diags
+	}
+
+	var arrays []client.RaidArray
+	for _, dRaid := range raidArrays {
+		arr := client.RaidArray{
+			FileSystem: PtrTo(client.FileSystem(dRaid.FileSystem.ValueString())),
+			MountPoint: dRaid.MountPoint.ValueStringPointer(),
+			Name:       dRaid.Name.ValueStringPointer(),
+			SizeBytes:  dRaid.SizeBytes.ValueInt64Pointer(),
+			Type:       PtrTo(client.RaidType(dRaid.Type.ValueString())),
+		}
+		diags.Append(
+			dRaid.Members.ElementsAs(ctx, &arr.Members, false)...,
+		)
+		arrays = append(arrays, arr)
+	}
+
+	return &arrays, diags
+}
+
+// metalFailedStates are the lower-cased service statuses and provisioning event
+// states that mean provisioning won't finish on its own.
+var metalFailedStates = map[string]bool{
+	"error":              true,
+	"failed":             true,
+	"failure":            true,
+	"provisioningfailed": true,
+}
+
+const (
+	// Number of provisioning events and log lines included in diagnostics
+	metalDiagEvents = 10
+	metalDiagLogs   = 20
+)
+
 func (r *MetalResource) waitInstanceReady(ctx context.Context, id int64) (*client.MetalService, error) {
-	// Set a default timeout of 30 minutes for metal provisioning
-	ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
-	defer cancel()
+	var (
+		service *client.MetalService
+		seen    int
+	)
 
 	for {
 		select {
 		case <-ctx.Done():
-			return nil, fmt.Errorf("timeout waiting for metal instance to become ready: %w", ctx.Err())
+			return nil, fmt.Errorf("timeout waiting for metal instance to become ready: %w%s",
+				ctx.Err(), r.provisioningDetails(context.WithoutCancel(ctx), id, service))
 		case <-time.After(3 * time.Second):
 		}
 
@@ -490,10 +941,50 @@ func (r *MetalResource) waitInstanceReady(ctx context.Context, id int64) (*clien
 		}
 
 		if res.StatusCode() != http.StatusOK {
-			return nil, fmt.Errorf("v2 metal returned an error: %s", string(res.Body))
+			return nil, fmt.Errorf("get metal service: %w", newAPIError(res.StatusCode(), res.Body))
+		}
+
+		if res.JSON200 == nil || res.JSON200.Result == nil {
+			continue
+		}
+		service = res.JSON200.Result
+
+		// Stream new provisioning events as they arrive
+		var failedEvent *client.ProvisioningEvent
+		if service.ProvisioningEvents != nil {
+			events := *service.ProvisioningEvents
+			if len(events) < seen {
+				seen = 0
+			}
+
+			for i := seen; i < len(events); i++ {
+				event := events[i]
+				tflog.Info(ctx, "metal provisioning event", map[string]interface{}{
+					"id":        id,
+					"state":     derefOr(event.State, ""),
+					"priority":  derefOr(event.Priority, 0),
+					"body":      derefOr(event.Body, ""),
+					"timestamp": derefOr(event.Timestamp, ""),
+				})
+
+				if event.State != nil && metalFailedStates[strings.ToLower(*event.State)] {
+					failedEvent = &events[i]
+				}
+			}
+			seen = len(events)
+		}
+
+		status := service.Status
+		if status != nil && metalFailedStates[strings.ToLower(*status)] {
+			return nil, fmt.Errorf("metal instance provisioning failed with status %q%s",
+				*status, r.provisioningDetails(ctx, id, service))
+		}
+
+		if failedEvent != nil {
+			return nil, fmt.Errorf("metal instance provisioning failed: %s%s",
+				derefOr(failedEvent.Body, derefOr(failedEvent.State, "")), r.provisioningDetails(ctx, id, service))
 		}
 
-		status := res.JSON200.Result.Status
 		if status == nil {
 			continue
 		}
@@ -506,10 +997,52 @@ func (r *MetalResource) waitInstanceReady(ctx context.Context, id int64) (*clien
 			continue
 		}
 
-		return res.JSON200.Result, nil
+		return service, nil
 	}
 }
 
+// provisioningDetails describes the most recent provisioning events of the
+// service and the most recent lines of its logs for use in error messages.
+func (r *MetalResource) provisioningDetails(ctx context.Context, id int64, service *client.MetalService) string {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var b strings.Builder
+
+	if service != nil && service.ProvisioningEvents != nil && len(*service.ProvisioningEvents) > 0 {
+		events := *service.ProvisioningEvents
+		if len(events) > metalDiagEvents {
+			events = events[len(events)-metalDiagEvents:]
+		}
+
+		b.WriteString("\n\nRecent provisioning events:")
+		for _, event := range events {
+			fmt.Fprintf(&b, "\n  [%s] %s (priority %d): %s",
+				derefOr(event.Timestamp, "-"), derefOr(event.State, "-"), derefOr(event.Priority, 0), derefOr(event.Body, ""))
+		}
+	}
+
+	res, err := r.providerData.client.GetV2MetalIdLogsWithResponse(ctx, id)
+	switch {
+	case err != nil:
+		fmt.Fprintf(&b, "\n\nUnable to get metal logs: %s", err)
+	case res.StatusCode() != http.StatusOK:
+		fmt.Fprintf(&b, "\n\nUnable to get metal logs: %s", newAPIError(res.StatusCode(), res.Body))
+	case res.JSON200 != nil && res.JSON200.Result != nil && len(*res.JSON200.Result) > 0:
+		// Sort like the teraswitch_metal_logs data source, as the API
+		// doesn't guarantee the order of log lines
+		logs := metalLogFilter{tail: metalDiagLogs}.apply(ctx, *res.JSON200.Result)
+
+		b.WriteString("\n\nRecent logs:")
+		for _, line := range logs {
+			fmt.Fprintf(&b, "\n  [%s] %s: %s",
+				derefOr(line.Timestamp, "-"), derefOr(line.Name, "-"), derefOr(line.Message, ""))
+		}
+	}
+
+	return b.String()
+}
+
 func (r *MetalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data MetalResourceModel
 
@@ -533,20 +1066,30 @@ func (r *MetalResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to get v2 metal, got error: %s", string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("get metal service", res.StatusCode(), res.Body, req.State.Raw)...)
 		return
 	}
 
-	metalService := res.JSON200.Result
-	if metalService == nil {
+	if res.JSON200 == nil || res.JSON200.Result == nil {
 		resp.Diagnostics.AddError("Client Error", "Metal service not found")
 		return
 	}
+	metalService := res.JSON200.Result
+
+	// Forget that provisioning didn't finish once the server becomes active
+	incomplete, diags := provisioningIncomplete(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if incomplete && metalService.Status != nil && *metalService.Status == "Active" {
+		tflog.Debug(ctx, "metal provisioning finished", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.Diagnostics.Append(clearProvisioningIncomplete(ctx, resp.Private)...)
+	}
 
 	// Update the state with values from the API response
-	data.ID = types.Int64Value(*metalService.Id)
+	if metalService.Id != nil {
+		data.ID = types.Int64Value(*metalService.Id)
+	}
 	if metalService.ProjectId != nil {
 		data.ProjectID = types.Int64Value(*metalService.ProjectId)
 	}
@@ -573,6 +1116,10 @@ func (r *MetalResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		data.IPAddresses = ipList
 	}
 
+	hardware, diags := newMetalHardware(ctx, *metalService)
+	resp.Diagnostics.Append(diags...)
+	data.setHardware(hardware)
+
 	if metalService.MemoryGb != nil {
 		data.MemoryGB = types.Int64Value(int64(*metalService.MemoryGb))
 	}
@@ -612,6 +1159,10 @@ func (r *MetalResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		data.WaitForReady = types.BoolValue(false)
 	}
 
+	if data.ReinstallOnChange.IsNull() {
+		data.ReinstallOnChange = types.BoolValue(true)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -626,12 +1177,110 @@ func (r *MetalResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, metalTimeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Read the current state
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resolvePriceEstimate(&plan.EstimatedHourly, &plan.EstimatedMonthly)
+
+	if plan.IPAddresses.IsUnknown() {
+		plan.IPAddresses = state.IPAddresses
+	}
+	if plan.Device.IsUnknown() {
+		plan.setHardware(state.hardware())
+	}
+
+	// Resume waiting for a server that was kept (untainted) after its
+	// provisioning didn't finish on create
+	incomplete, diags := provisioningIncomplete(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if incomplete {
+		tflog.Debug(ctx, "resuming wait for metal provisioning", map[string]interface{}{
+			"id": state.ID.ValueInt64(),
+		})
+
+		final, err := r.waitInstanceReady(ctx, state.ID.ValueInt64())
+		if err != nil {
+			// Keep the prior state, so the next apply resumes waiting again
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to wait v2 metal instance ready, got error: %s", err),
+			)
+			return
+		}
+
+		if final.IpAddresses != nil {
+			plan.IPAddresses, diags = types.ListValueFrom(ctx, types.StringType, *final.IpAddresses)
+			resp.Diagnostics.Append(diags...)
+		}
+
+		hardware, diags := newMetalHardware(ctx, *final)
+		resp.Diagnostics.Append(diags...)
+		plan.setHardware(hardware)
+
+		resp.Diagnostics.Append(clearProvisioningIncomplete(ctx, resp.Private)...)
+	}
+
+	wasImported, diags := imported(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if reinstallChanged(req.Plan.Raw, req.State.Raw, wasImported) {
+		tflog.Debug(ctx, "reinstalling metal service", map[string]interface{}{
+			"id": state.ID.ValueInt64(),
+		})
+
+		if plan.ProjectID.IsNull() {
+			plan.ProjectID = state.ProjectID
+		}
+
+		resp.Diagnostics.Append(r.reinstall(ctx, state.ID.ValueInt64(), plan, req.Plan.Raw)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		final, err := r.waitInstanceReady(ctx, state.ID.ValueInt64())
+		if err != nil {
+			// The reinstall was accepted, so track the new configuration and
+			// resume waiting on the next apply.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.Append(markProvisioningIncomplete(ctx, resp.Private)...)
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to wait v2 metal instance ready after reinstall, got error: %s\n\n"+
+					"The next apply will resume waiting for metal server %d.", err, state.ID.ValueInt64()),
+			)
+			return
+		}
+
+		if final.IpAddresses != nil {
+			plan.IPAddresses, diags = types.ListValueFrom(ctx, types.StringType, *final.IpAddresses)
+			resp.Diagnostics.Append(diags...)
+		}
+
+		hardware, diags := newMetalHardware(ctx, *final)
+		resp.Diagnostics.Append(diags...)
+		plan.setHardware(hardware)
+
+		tflog.Trace(ctx, "reinstalled v2 metal")
+	}
+
 	if !plan.DisplayName.Equal(state.DisplayName) && !plan.DisplayName.IsNull() {
 		tflog.Debug(ctx, "display name changed, updating...", map[string]interface{}{
 			"old_display_name": state.DisplayName.String(),
@@ -646,9 +1295,7 @@ func (r *MetalResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 
 		if res.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError("Client Error",
-				fmt.Sprintf("Unable to update v2 metal name, got error: %s", string(res.Body)),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("rename metal service", res.StatusCode(), res.Body, req.Plan.Raw)...)
 			return
 		}
 		tflog.Trace(ctx, "display name updated")
@@ -694,13 +1341,16 @@ func (r *MetalResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 
 		if res.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update v2 metal power status, got error: %s", string(res.Body)))
+			resp.Diagnostics.Append(apiErrorDiagnostics("update metal service power state", res.StatusCode(), res.Body, req.Plan.Raw)...)
 			return
 		}
 
 		tflog.Debug(ctx, "power state updated")
 	}
 
+	// Values missing from state were adopted by this apply
+	resp.Diagnostics.Append(clearImported(ctx, resp.Private)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -715,38 +1365,85 @@ func (r *MetalResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, metalTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// /v2/Metal doesn't support deletion currently, use v1 endpoint
 	projectID := data.ProjectID.ValueInt64()
 	if projectID == 0 {
 		projectID = r.providerData.projectID
 	}
-	delReq, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/v1/Metal/%d?projectId=%d", r.providerData.apiURL, data.ID.ValueInt64(), projectID), nil)
+	status, body, err := r.providerData.doRequest(ctx, http.MethodDelete,
+		fmt.Sprintf("/v1/Metal/%d", data.ID.ValueInt64()),
+		url.Values{"projectId": []string{strconv.FormatInt(projectID, 10)}},
+	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("failed to create delete v2 metal request: %s", err),
-		)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete v2 metal, got error: %s", err))
 		return
 	}
-	delReq.Header.Add("Authorization", "Bearer "+r.providerData.apiKey)
 
-	res, err := r.providerData.httpClient.Do(delReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("failed to send delete v2 metal request: %s", err),
-		)
+	if status == http.StatusNotFound {
+		// Metal service already deleted, nothing to do
 		return
 	}
-	defer func() { _ = res.Body.Close() }()
 
-	body := bytes.NewBuffer(nil)
-	_, _ = io.Copy(body, res.Body)
+	if status != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("delete metal service", status, body, req.State.Raw)...)
+		return
+	}
 
-	if res.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("delete v2 metal request errored: %s", body.String()),
-		)
+	if err := r.waitDeleted(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for v2 metal deletion, got error: %s", err))
 		return
 	}
+
+	tflog.Trace(ctx, "deleted v2 metal")
+}
+
+// waitDeleted waits until the metal service is gone or reports that it has
+// been deleted or terminated.
+func (r *MetalResource) waitDeleted(ctx context.Context, id int64) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for metal instance to be deleted: %w", ctx.Err())
+		case <-time.After(3 * time.Second):
+		}
+
+		res, err := r.providerData.client.GetV2MetalIdWithResponse(ctx, id)
+		if err != nil {
+			return fmt.Errorf("send get metal v2 request: %w", err)
+		}
+
+		if res.StatusCode() == http.StatusNotFound {
+			return nil
+		}
+
+		if res.StatusCode() != http.StatusOK {
+			return fmt.Errorf("get metal service: %w", newAPIError(res.StatusCode(), res.Body))
+		}
+
+		if res.JSON200 == nil || res.JSON200.Result == nil {
+			return nil
+		}
+
+		service := res.JSON200.Result
+		status := derefOr(service.Status, "")
+		if derefOr(service.Deleted, "") != "" || strings.EqualFold(status, "Terminated") || strings.EqualFold(status, "Deleted") {
+			return nil
+		}
+
+		tflog.Debug(ctx, "waiting for metal deletion", map[string]interface{}{
+			"id":             id,
+			"current_status": status,
+		})
+	}
 }
 
 func (r *MetalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -766,6 +1463,7 @@ func (r *MetalResource) ImportState(ctx context.Context, req resource.ImportStat
 
 	// Set default values for required fields to avoid null/unknown issues
 	state.WaitForReady = types.BoolValue(false)
+	state.ReinstallOnChange = types.BoolValue(true)
 	state.DesiredPowerState = types.StringValue("On")
 
 	// Initialize empty typed collections to avoid type validation errors
@@ -773,6 +1471,12 @@ func (r *MetalResource) ImportState(ctx context.Context, req resource.ImportStat
 	state.Tags = types.ListNull(types.StringType)
 	state.SSHKeyIDs = types.ListNull(types.Int64Type)
 	state.IPAddresses = types.ListNull(types.StringType)
+	state.setHardware(nullMetalHardware())
+	state.Timeouts = nullTimeouts()
+
+	// Values the API doesn't return are adopted from configuration on the
+	// next apply
+	resp.Diagnostics.Append(markImported(ctx, resp.Private)...)
 
 	// Set the state directly - this will trigger a Read to populate the rest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -828,7 +1532,7 @@ func (r *MetalResource) updateTags(ctx context.Context, serviceID int64, oldTags
 				continue
 			}
 			if res.StatusCode() != http.StatusOK {
-				diags.AddError("Client Error", fmt.Sprintf("Unable to remove tag %q, got error: %s", tag, string(res.Body)))
+				diags.Append(apiErrorDiagnostics(fmt.Sprintf("remove tag %q", tag), res.StatusCode(), res.Body, tftypes.Value{})...)
 				continue
 			}
 		}
@@ -850,7 +1554,7 @@ func (r *MetalResource) updateTags(ctx context.Context, serviceID int64, oldTags
 				continue
 			}
 			if res.StatusCode() != http.StatusOK {
-				diags.AddError("Client Error", fmt.Sprintf("Unable to add tag %q, got error: %s", tag, string(res.Body)))
+				diags.Append(apiErrorDiagnostics(fmt.Sprintf("add tag %q", tag), res.StatusCode(), res.Body, tftypes.Value{})...)
 				continue
 			}
 		}
@@ -3,12 +3,14 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"text/template"
 
 	"github.com/TeraSwitch/terraform-provider/client"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/stretchr/testify/require"
 )
 
@@ -114,6 +116,150 @@ func TestAccMetalResource(t *testing.T) {
 	})
 }
 
+func TestAccMetalResource_reinstall(t *testing.T) {
+	if os.Getenv("CI") != "" {
+		t.Skip("Skipping, metal tests are not run in CI")
+		return
+	}
+
+	cfg1 := metalCfg7950
+	cfg1.UserData = PtrTo("#cloud-config\nhostname: initial\n")
+
+	reinstalled := cfg1
+	reinstalled.UserData = PtrTo("#cloud-config\nhostname: reinstalled\n")
+
+	replaced := reinstalled
+	replaced.UserData = PtrTo("#cloud-config\nhostname: replaced\n")
+	replaced.ReinstallOnChange = PtrTo(false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg1.String(t),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teraswitch_metal.test", "reinstall_on_change", "true"),
+				),
+			},
+			{
+				Config: reinstalled.String(t),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("teraswitch_metal.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teraswitch_metal.test", "user_data", *reinstalled.UserData),
+				),
+			},
+			{
+				Config: replaced.String(t),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("teraswitch_metal.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccMetalResource_import(t *testing.T) {
+	if os.Getenv("CI") != "" {
+		t.Skip("Skipping, metal tests are not run in CI")
+		return
+	}
+
+	cfg := metalCfg7950
+	cfg.UserData = PtrTo("#cloud-config\nhostname: imported\n")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg.String(t),
+			},
+			{
+				ResourceName:       "teraswitch_metal.test",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			// The imported server adopts the configured ssh_key_ids, user_data
+			// and raid_arrays without being reinstalled or replaced.
+			{
+				Config: cfg.String(t),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("teraswitch_metal.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccMetalResource_invalidStorage(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	singleMember := metalCfg7950
+	singleMember.RaidArrays = PtrTo([]RaidArray{
+		{
+			Name:       PtrTo("md0"),
+			Type:       PtrTo("Raid1"),
+			Members:    PtrTo([]string{"nvme0n1"}),
+			FileSystem: PtrTo(string(client.FileSystemExt4)),
+			MountPoint: PtrTo("/"),
+		},
+	})
+
+	unknownMember := metalCfg7950
+	unknownMember.RaidArrays = PtrTo([]RaidArray{
+		{
+			Name:       PtrTo("md0"),
+			Type:       PtrTo("Raid1"),
+			Members:    PtrTo([]string{"nvme0n1", "sda"}),
+			FileSystem: PtrTo(string(client.FileSystemExt4)),
+			MountPoint: PtrTo("/"),
+		},
+	})
+
+	unknownDriveOption := metalCfg7950
+	unknownDriveOption.Disks = PtrTo(map[string]string{
+		"nvme0n1": "100t",
+		"nvme1n1": "1.92t",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      singleMember.String(t),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Not Enough RAID Members"),
+			},
+			{
+				Config:      unknownMember.String(t),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unknown RAID Member"),
+			},
+			{
+				Config:      unknownDriveOption.String(t),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unknown Drive Option"),
+			},
+		},
+	})
+}
+
 type testAccMetalResourceConfig struct {
 	RegionID          *string
 	DisplayName       *string
@@ -121,6 +267,7 @@ type testAccMetalResourceConfig struct {
 	ProjectID         *int
 	SSHKeyIDs         *[]int
 	Password          *string
+	UserData          *string
 	Tags              *[]string
 	MemoryGB          *int
 	Disks             *map[string]string
@@ -129,6 +276,7 @@ type testAccMetalResourceConfig struct {
 	ReservePricing    *bool
 	DesiredPowerState *string
 	WaitForReady      *bool
+	ReinstallOnChange *bool
 }
 
 type RaidArray struct {
@@ -151,6 +299,7 @@ resource "teraswitch_metal" "test" {
 	project_id   = {{orNull .ProjectID}}
 	ssh_key_ids  = {{orNull .SSHKeyIDs}}
 	password     = {{orNull .Password}}
+	user_data    = {{orNull .UserData}}
 	tags         = {{orNull .Tags}}
 	memory_gb    = {{orNull .MemoryGB}}
 	disks = {
@@ -174,6 +323,7 @@ resource "teraswitch_metal" "test" {
 	reserve_pricing = {{orNull .ReservePricing}}
 	desired_power_state = {{orNull .DesiredPowerState}}
 	wait_for_ready = {{orNull .WaitForReady}}
+	reinstall_on_change = {{orNull .ReinstallOnChange}}
 }
 `
 
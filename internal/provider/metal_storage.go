@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// raidMinMembers is the minimum number of members of each RAID type.
+var raidMinMembers = map[string]int{
+	string(client.RaidTypeNone):  1,
+	string(client.RaidTypeRaid0): 2,
+	string(client.RaidTypeRaid1): 2,
+}
+
+// metalStorage is the storage layout of a metal service as configured or
+// planned. The known flags are false while the attribute is unknown, in which
+// case the checks depending on it are skipped.
+type metalStorage struct {
+	Disks           map[string]string
+	DisksKnown      bool
+	Partitions      []MetalPartitionModel
+	PartitionsKnown bool
+	RaidArrays      []MetalRaidArrayModel
+	RaidArraysKnown bool
+}
+
+// getAttributeFunc matches the GetAttribute method of tfsdk.Config and
+// tfsdk.Plan.
+type getAttributeFunc func(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+
+// readMetalStorage reads the disks, partitions and raid_arrays attributes,
+// tolerating unknown values.
+func readMetalStorage(ctx context.Context, get getAttributeFunc) (metalStorage, diag.Diagnostics) {
+	var s metalStorage
+	var diags diag.Diagnostics
+
+	var disks types.Map
+	diags.Append(get(ctx, path.Root("disks"), &disks)...)
+	if !disks.IsUnknown() {
+		s.DisksKnown = true
+		if !disks.IsNull() {
+			var elems map[string]types.String
+			diags.Append(disks.ElementsAs(ctx, &elems, false)...)
+			s.Disks = make(map[string]string, len(elems))
+			for k, v := range elems {
+				if v.IsUnknown() {
+					s.DisksKnown = false
+				}
+				s.Disks[k] = v.ValueString()
+			}
+		}
+	}
+
+	var partitions types.List
+	diags.Append(get(ctx, path.Root("partitions"), &partitions)...)
+	if !partitions.IsUnknown() {
+		s.PartitionsKnown = true
+		if !partitions.IsNull() {
+			diags.Append(partitions.ElementsAs(ctx, &s.Partitions, false)...)
+		}
+	}
+
+	var raidArrays types.List
+	diags.Append(get(ctx, path.Root("raid_arrays"), &raidArrays)...)
+	if !raidArrays.IsUnknown() {
+		s.RaidArraysKnown = true
+		if !raidArrays.IsNull() {
+			diags.Append(raidArrays.ElementsAs(ctx, &s.RaidArrays, false)...)
+		}
+	}
+
+	return s, diags
+}
+
+// customized reports whether the layout has custom partitions or RAID arrays.
+func (s metalStorage) customized() bool {
+	return len(s.Partitions) > 0 || len(s.RaidArrays) > 0
+}
+
+// validate runs the checks that need no API access. diskNames are the
+// physical devices of the service; when nil, device references are not
+// checked.
+func (s metalStorage) validate(ctx context.Context, diskNames map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raidNames := map[string]bool{}
+	for _, raid := range s.RaidArrays {
+		raidNames[raid.Name.ValueString()] = true
+	}
+	partitionNames := map[string]bool{}
+	for _, part := range s.Partitions {
+		partitionNames[part.Name.ValueString()] = true
+	}
+
+	checkRefs := diskNames != nil && s.PartitionsKnown && s.RaidArraysKnown
+
+	for i, raid := range s.RaidArrays {
+		if raid.Members.IsUnknown() || raid.Members.IsNull() {
+			continue
+		}
+
+		var members []types.String
+		diags.Append(raid.Members.ElementsAs(ctx, &members, false)...)
+
+		membersPath := path.Root("raid_arrays").AtListIndex(i).AtName("members")
+		raidType := raid.Type.ValueString()
+		if min, ok := raidMinMembers[raidType]; ok && !raid.Type.IsUnknown() && len(members) < min {
+			diags.AddAttributeError(membersPath, "Not Enough RAID Members",
+				fmt.Sprintf("RAID array %q of type %s needs at least %d members, got %d.",
+					raid.Name.ValueString(), raidType, min, len(members)))
+		}
+
+		seen := map[string]bool{}
+		for j, member := range members {
+			if member.IsUnknown() {
+				continue
+			}
+			name := member.ValueString()
+			if seen[name] {
+				diags.AddAttributeError(membersPath.AtListIndex(j), "Duplicate RAID Member",
+					fmt.Sprintf("RAID array %q lists member %q more than once.", raid.Name.ValueString(), name))
+			}
+			seen[name] = true
+
+			if checkRefs && !diskNames[name] && !partitionNames[name] {
+				diags.AddAttributeError(membersPath.AtListIndex(j), "Unknown RAID Member",
+					fmt.Sprintf("RAID array %q references %q, which is not a disk or partition. Available disks: %s.",
+						raid.Name.ValueString(), name, strings.Join(sortedKeys(diskNames), ", ")))
+			}
+		}
+	}
+
+	if checkRefs {
+		for i, part := range s.Partitions {
+			if part.Device.IsUnknown() {
+				continue
+			}
+			device := part.Device.ValueString()
+			if !diskNames[device] && !raidNames[device] {
+				diags.AddAttributeError(path.Root("partitions").AtListIndex(i).AtName("device"), "Unknown Partition Device",
+					fmt.Sprintf("Partition %q is on device %q, which is not a disk or RAID array. Available disks: %s.",
+						part.Name.ValueString(), device, strings.Join(sortedKeys(diskNames), ", ")))
+			}
+		}
+	}
+
+	mountPoints := map[string]string{}
+	checkMount := func(p path.Path, owner string, mount, fs types.String) {
+		if mount.IsUnknown() || fs.IsUnknown() || !mountable(mount.ValueString(), fs.ValueString()) {
+			return
+		}
+		if other, ok := mountPoints[mount.ValueString()]; ok {
+			diags.AddAttributeError(p, "Duplicate Mount Point",
+				fmt.Sprintf("%s and %s are both mounted at %q.", other, owner, mount.ValueString()))
+			return
+		}
+		mountPoints[mount.ValueString()] = owner
+	}
+	for i, part := range s.Partitions {
+		checkMount(path.Root("partitions").AtListIndex(i).AtName("mount_point"),
+			fmt.Sprintf("partition %q", part.Name.ValueString()), part.MountPoint, part.FileSystem)
+	}
+	for i, raid := range s.RaidArrays {
+		checkMount(path.Root("raid_arrays").AtListIndex(i).AtName("mount_point"),
+			fmt.Sprintf("RAID array %q", raid.Name.ValueString()), raid.MountPoint, raid.FileSystem)
+	}
+
+	return diags
+}
+
+// mountable reports whether a volume with the given mount point and file
+// system is actually mounted, and so must have a unique mount point.
+func mountable(mountPoint, fileSystem string) bool {
+	switch fileSystem {
+	case string(client.FileSystemSwap), string(client.FileSystemUnformatted):
+		return false
+	}
+	switch strings.ToLower(mountPoint) {
+	case "", "none", "swap":
+		return false
+	}
+	return true
+}
+
+// validateCapacity checks the disks against the tier's drive slots and that
+// the partitions placed directly on a disk fit its capacity. It returns the
+// disk names in use, falling back to the tier's default drives when disks are
+// not configured.
+func (s metalStorage) validateCapacity(tier client.MetalTier) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	slots := map[string]client.DriveSlot{}
+	if tier.DriveSlots != nil {
+		for _, slot := range *tier.DriveSlots {
+			if slot.Id != nil {
+				slots[*slot.Id] = slot
+			}
+		}
+	}
+
+	disks := s.Disks
+	if disks == nil {
+		disks = map[string]string{}
+		for id, slot := range slots {
+			if derefOr(slot.Default, "") != "" {
+				disks[id] = *slot.Default
+			}
+		}
+	}
+
+	diskNames := make(map[string]bool, len(disks))
+	capacityBytes := map[string]int64{}
+	for name, size := range disks {
+		diskNames[name] = true
+
+		slot, ok := slots[name]
+		if !ok {
+			if len(slots) > 0 {
+				diags.AddAttributeError(path.Root("disks").AtMapKey(name), "Unknown Drive Slot",
+					fmt.Sprintf("Tier %s has no drive slot %q. Available slots: %s.",
+						derefOr(tier.Id, ""), name, strings.Join(sortedKeys(slots), ", ")))
+			}
+			continue
+		}
+
+		var names []string
+		found := false
+		if slot.Options != nil {
+			for _, option := range *slot.Options {
+				names = append(names, derefOr(option.Name, ""))
+				if strings.EqualFold(derefOr(option.Name, ""), size) {
+					found = true
+					if option.CapacityGb != nil {
+						capacityBytes[name] = int64(*option.CapacityGb) * 1000 * 1000 * 1000
+					}
+				}
+			}
+		}
+		if !found && len(names) > 0 {
+			sort.Strings(names)
+			diags.AddAttributeError(path.Root("disks").AtMapKey(name), "Unknown Drive Option",
+				fmt.Sprintf("Drive slot %q of tier %s has no %q option. Available options: %s.",
+					name, derefOr(tier.Id, ""), size, strings.Join(names, ", ")))
+		}
+	}
+
+	used := map[string]int64{}
+	for i, part := range s.Partitions {
+		if part.Device.IsUnknown() || part.SizeBytes.IsUnknown() || part.SizeBytes.IsNull() {
+			continue
+		}
+		device := part.Device.ValueString()
+		capacity, ok := capacityBytes[device]
+		if !ok {
+			continue
+		}
+		used[device] += part.SizeBytes.ValueInt64()
+		if used[device] > capacity {
+			diags.AddAttributeError(path.Root("partitions").AtListIndex(i).AtName("size_bytes"), "Partition Exceeds Disk Capacity",
+				fmt.Sprintf("Partitions on %s add up to %d bytes, more than the %d bytes of the %s drive.",
+					device, used[device], capacity, disks[device]))
+		}
+	}
+
+	return diskNames, diags
+}
+
+// findMetalTier returns the metal tier with the given ID, or nil if there is
+// none.
+func findMetalTier(ctx context.Context, c *client.ClientWithResponses, id string) (*client.MetalTier, error) {
+	res, err := c.GetV2MetalTiersWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("list metal tiers: %w", newAPIError(res.StatusCode(), res.Body))
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		return nil, nil
+	}
+
+	for _, tier := range *res.JSON200.Result {
+		if derefOr(tier.Id, "") == id {
+			return &tier, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findImage returns the image with the given ID, or nil if there is none.
+func findImage(ctx context.Context, c *client.ClientWithResponses, id string) (*client.Image, error) {
+	images, err := listImages(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, image := range images {
+		if derefOr(image.Id, "") == id {
+			return &image, nil
+		}
+	}
+
+	return nil, nil
+}
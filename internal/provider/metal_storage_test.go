@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRaid(name, raidType, mount string, members ...string) MetalRaidArrayModel {
+	elems := make([]attr.Value, len(members))
+	for i, m := range members {
+		elems[i] = types.StringValue(m)
+	}
+	return MetalRaidArrayModel{
+		Name:       types.StringValue(name),
+		Type:       types.StringValue(raidType),
+		Members:    types.ListValueMust(types.StringType, elems),
+		SizeBytes:  types.Int64Null(),
+		FileSystem: types.StringValue("Ext4"),
+		MountPoint: types.StringValue(mount),
+	}
+}
+
+func testPartition(name, device, mount string, size int64) MetalPartitionModel {
+	sizeBytes := types.Int64Null()
+	if size > 0 {
+		sizeBytes = types.Int64Value(size)
+	}
+	return MetalPartitionModel{
+		Name:       types.StringValue(name),
+		Device:     types.StringValue(device),
+		SizeBytes:  sizeBytes,
+		FileSystem: types.StringValue("Ext4"),
+		MountPoint: types.StringValue(mount),
+	}
+}
+
+func diagSummaries(diags diag.Diagnostics) string {
+	var summaries []string
+	for _, d := range diags {
+		summaries = append(summaries, d.Summary())
+	}
+	return strings.Join(summaries, "; ")
+}
+
+func TestMetalStorageValidate(t *testing.T) {
+	disks := map[string]bool{"nvme0n1": true, "nvme1n1": true}
+
+	tests := []struct {
+		name    string
+		storage metalStorage
+		want    string
+	}{
+		{
+			name: "valid raid1",
+			storage: metalStorage{
+				RaidArrays: []MetalRaidArrayModel{testRaid("md0", "Raid1", "/", "nvme0n1", "nvme1n1")},
+			},
+		},
+		{
+			name: "raid1 with one member",
+			storage: metalStorage{
+				RaidArrays: []MetalRaidArrayModel{testRaid("md0", "Raid1", "/", "nvme0n1")},
+			},
+			want: "Not Enough RAID Members",
+		},
+		{
+			name: "duplicate member",
+			storage: metalStorage{
+				RaidArrays: []MetalRaidArrayModel{testRaid("md0", "Raid1", "/", "nvme0n1", "nvme0n1")},
+			},
+			want: "Duplicate RAID Member",
+		},
+		{
+			name: "unknown raid member",
+			storage: metalStorage{
+				RaidArrays: []MetalRaidArrayModel{testRaid("md0", "Raid1", "/", "nvme0n1", "sda")},
+			},
+			want: "Unknown RAID Member",
+		},
+		{
+			name: "raid over partitions",
+			storage: metalStorage{
+				Partitions: []MetalPartitionModel{
+					testPartition("p0", "nvme0n1", "none", 0),
+					testPartition("p1", "nvme1n1", "none", 0),
+				},
+				RaidArrays: []MetalRaidArrayModel{testRaid("md0", "Raid1", "/", "p0", "p1")},
+			},
+		},
+		{
+			name: "partition on raid",
+			storage: metalStorage{
+				Partitions: []MetalPartitionModel{testPartition("root", "md0", "/", 0)},
+				RaidArrays: []MetalRaidArrayModel{testRaid("md0", "Raid1", "none", "nvme0n1", "nvme1n1")},
+			},
+		},
+		{
+			name: "unknown partition device",
+			storage: metalStorage{
+				Partitions: []MetalPartitionModel{testPartition("root", "sda", "/", 0)},
+			},
+			want: "Unknown Partition Device",
+		},
+		{
+			name: "duplicate mount point",
+			storage: metalStorage{
+				Partitions: []MetalPartitionModel{
+					testPartition("a", "nvme0n1", "/data", 0),
+					testPartition("b", "nvme1n1", "/data", 0),
+				},
+			},
+			want: "Duplicate Mount Point",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.storage.DisksKnown, tt.storage.PartitionsKnown, tt.storage.RaidArraysKnown = true, true, true
+			got := diagSummaries(tt.storage.validate(context.Background(), disks))
+			if got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMetalStorageValidateCapacity(t *testing.T) {
+	option := func(name string, capacity int32) client.MetalStorageDevice {
+		return client.MetalStorageDevice{Name: PtrTo(name), CapacityGb: PtrTo(capacity)}
+	}
+	tier := client.MetalTier{
+		Id: PtrTo("7950x"),
+		DriveSlots: &[]client.DriveSlot{
+			{Id: PtrTo("nvme0n1"), Default: PtrTo("960g"), Options: &[]client.MetalStorageDevice{option("960g", 960), option("1.92t", 1920)}},
+			{Id: PtrTo("nvme1n1"), Options: &[]client.MetalStorageDevice{option("960g", 960), option("1.92t", 1920)}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		storage   metalStorage
+		wantDisks string
+		want      string
+	}{
+		{
+			name:      "defaults",
+			storage:   metalStorage{Partitions: []MetalPartitionModel{testPartition("root", "nvme0n1", "/", 900_000_000_000)}},
+			wantDisks: "nvme0n1",
+		},
+		{
+			name:      "too large for default drive",
+			storage:   metalStorage{Partitions: []MetalPartitionModel{testPartition("root", "nvme0n1", "/", 1_000_000_000_000)}},
+			wantDisks: "nvme0n1",
+			want:      "Partition Exceeds Disk Capacity",
+		},
+		{
+			name: "fits larger drive",
+			storage: metalStorage{
+				Disks:      map[string]string{"nvme0n1": "1.92t"},
+				Partitions: []MetalPartitionModel{testPartition("root", "nvme0n1", "/", 1_000_000_000_000)},
+			},
+			wantDisks: "nvme0n1",
+		},
+		{
+			name: "sum exceeds capacity",
+			storage: metalStorage{
+				Disks: map[string]string{"nvme0n1": "960g"},
+				Partitions: []MetalPartitionModel{
+					testPartition("a", "nvme0n1", "/", 500_000_000_000),
+					testPartition("b", "nvme0n1", "/data", 500_000_000_000),
+				},
+			},
+			wantDisks: "nvme0n1",
+			want:      "Partition Exceeds Disk Capacity",
+		},
+		{
+			name:      "unknown slot",
+			storage:   metalStorage{Disks: map[string]string{"sda": "960g"}},
+			wantDisks: "sda",
+			want:      "Unknown Drive Slot",
+		},
+		{
+			name:      "unknown option",
+			storage:   metalStorage{Disks: map[string]string{"nvme1n1": "4t"}},
+			wantDisks: "nvme1n1",
+			want:      "Unknown Drive Option",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diskNames, diags := tt.storage.validateCapacity(tier)
+			if got := strings.Join(sortedKeys(diskNames), ","); got != tt.wantDisks {
+				t.Fatalf("expected disks %q, got %q", tt.wantDisks, got)
+			}
+			if got := diagSummaries(diags); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
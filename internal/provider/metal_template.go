@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// metalTemplateDefaults are the values a metal template supplies for the
+// teraswitch_metal attributes left unset in configuration.
+type metalTemplateDefaults struct {
+	TierID     types.String
+	ImageID    types.String
+	MemoryGB   types.Int64
+	Disks      types.Map
+	Partitions []MetalPartitionModel
+	RaidArrays []MetalRaidArrayModel
+}
+
+// listMetalTemplates returns all metal templates known to the API.
+func listMetalTemplates(ctx context.Context, c *client.ClientWithResponses) ([]client.MetalTemplate, error) {
+	res, err := c.GetV2MetalTemplatesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("list metal templates: %w", newAPIError(res.StatusCode(), res.Body))
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		return nil, nil
+	}
+
+	return *res.JSON200.Result, nil
+}
+
+// findMetalTemplate returns the metal template with the given ID, or nil if
+// there is none.
+func findMetalTemplate(ctx context.Context, c *client.ClientWithResponses, id int64) (*client.MetalTemplate, error) {
+	templates, err := listMetalTemplates(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, template := range templates {
+		if derefOr(template.Id, 0) == id {
+			return &template, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func newMetalTemplateDefaults(ctx context.Context, create client.CreateMetalRequest) (metalTemplateDefaults, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defaults := metalTemplateDefaults{
+		TierID:   types.StringNull(),
+		ImageID:  types.StringPointerValue(create.ImageId),
+		MemoryGB: types.Int64Null(),
+		Disks:    types.MapNull(types.StringType),
+	}
+
+	if create.TierId != "" {
+		defaults.TierID = types.StringValue(create.TierId)
+	}
+
+	if create.MemoryGb != nil {
+		defaults.MemoryGB = types.Int64Value(int64(*create.MemoryGb))
+	}
+
+	if create.Disks != nil {
+		disks := make(map[string]attr.Value, len(*create.Disks))
+		for name, size := range *create.Disks {
+			disks[name] = types.StringPointerValue(size)
+		}
+
+		var d diag.Diagnostics
+		defaults.Disks, d = types.MapValue(types.StringType, disks)
+		diags.Append(d...)
+	}
+
+	if create.Partitions != nil {
+		for _, part := range *create.Partitions {
+			defaults.Partitions = append(defaults.Partitions, MetalPartitionModel{
+				Name:       types.StringPointerValue(part.Name),
+				Device:     types.StringPointerValue(part.Device),
+				SizeBytes:  types.Int64PointerValue(part.SizeBytes),
+				FileSystem: fileSystemValue(part.FileSystem),
+				MountPoint: types.StringPointerValue(part.MountPoint),
+			})
+		}
+	}
+
+	if create.RaidArrays != nil {
+		for _, array := range *create.RaidArrays {
+			members, d := types.ListValueFrom(ctx, types.StringType, array.Members)
+			diags.Append(d...)
+
+			raidType := types.StringNull()
+			if array.Type != nil {
+				raidType = types.StringValue(string(*array.Type))
+			}
+
+			defaults.RaidArrays = append(defaults.RaidArrays, MetalRaidArrayModel{
+				Name:       types.StringPointerValue(array.Name),
+				Type:       raidType,
+				Members:    members,
+				SizeBytes:  types.Int64PointerValue(array.SizeBytes),
+				FileSystem: fileSystemValue(array.FileSystem),
+				MountPoint: types.StringPointerValue(array.MountPoint),
+			})
+		}
+	}
+
+	return defaults, diags
+}
+
+func fileSystemValue(fs *client.FileSystem) types.String {
+	if fs == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(*fs))
+}
+
+// applyTemplate plans the template_id template's values for the template
+// attributes left unset in configuration, so they are visible before apply.
+// Servers that aren't deployed from a new template get null values instead.
+// Existing servers are planned by templateDefaultModifier.
+func (r *MetalResource) applyTemplate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !req.State.Raw.IsNull() && !planChanged(req, "template_id") {
+		return diags
+	}
+
+	var templateID types.Int64
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	if diags.HasError() || templateID.IsUnknown() {
+		return diags
+	}
+
+	defaults := metalTemplateDefaults{
+		TierID:   types.StringNull(),
+		ImageID:  types.StringNull(),
+		MemoryGB: types.Int64Null(),
+		Disks:    types.MapNull(types.StringType),
+	}
+
+	if !templateID.IsNull() {
+		template, err := findMetalTemplate(ctx, r.providerData.client, templateID.ValueInt64())
+		if err != nil {
+			// The server still takes its values from the template. The tier,
+			// image and memory are read back once it is created, the storage
+			// layout can't be and is left null.
+			tflog.Warn(ctx, "unable to read metal template, its values will be known after apply", map[string]interface{}{
+				"template_id": templateID.ValueInt64(),
+				"error":       err.Error(),
+			})
+			diags.Append(setUnconfigured(ctx, req, resp, map[string]interface{}{
+				"disks":       types.MapNull(types.StringType),
+				"partitions":  []MetalPartitionModel(nil),
+				"raid_arrays": []MetalRaidArrayModel(nil),
+			})...)
+			return diags
+		}
+
+		if template == nil {
+			diags.AddAttributeError(path.Root("template_id"), "Metal Template Not Found",
+				fmt.Sprintf("Metal template %d doesn't exist. The teraswitch_metal_templates data source lists the available templates.", templateID.ValueInt64()))
+			return diags
+		}
+
+		if template.CreateModel != nil {
+			var d diag.Diagnostics
+			defaults, d = newMetalTemplateDefaults(ctx, *template.CreateModel)
+			diags.Append(d...)
+		}
+	}
+
+	diags.Append(setUnconfigured(ctx, req, resp, map[string]interface{}{
+		"tier_id":     defaults.TierID,
+		"image_id":    defaults.ImageID,
+		"memory_gb":   defaults.MemoryGB,
+		"disks":       defaults.Disks,
+		"partitions":  defaults.Partitions,
+		"raid_arrays": defaults.RaidArrays,
+	})...)
+
+	return diags
+}
+
+// setUnconfigured plans the given values for the attributes that are null in
+// configuration.
+func setUnconfigured(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, values map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range sortedKeys(values) {
+		configured, _, err := tftypes.WalkAttributePath(req.Config.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if value, ok := configured.(tftypes.Value); err != nil || !ok || !value.IsNull() {
+			continue
+		}
+
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root(name), values[name])...)
+	}
+
+	return diags
+}
+
+// templateDefaultModifier plans the template attributes of an existing server
+// that are unset in configuration. A server deployed from a template keeps
+// its values from state, like UseStateForUnknown. Any other server plans
+// null, so removing a value from its configuration reinstalls or replaces it.
+// New servers are planned by applyTemplate.
+type templateDefaultModifier struct{}
+
+func useStateForTemplateDefault() templateDefaultModifier {
+	return templateDefaultModifier{}
+}
+
+func (m templateDefaultModifier) Description(ctx context.Context) string {
+	return "Once set, the template's value is kept unless the configuration changes it."
+}
+
+func (m templateDefaultModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// plannedValue returns the value to plan given the attribute's configured,
+// planned and prior state values.
+func (m templateDefaultModifier) plannedValue(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, config, planned, prior attr.Value) (attr.Value, diag.Diagnostics) {
+	if state.Raw.IsNull() || !config.IsNull() || !planned.IsUnknown() {
+		return planned, nil
+	}
+
+	var templateID types.Int64
+	diags := plan.GetAttribute(ctx, path.Root("template_id"), &templateID)
+
+	switch {
+	case diags.HasError() || templateID.IsUnknown():
+		return planned, diags
+	case templateID.IsNull():
+		// The null configuration value is typed like the attribute
+		return config, diags
+	default:
+		return prior, diags
+	}
+}
+
+func (m templateDefaultModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	value, diags := m.plannedValue(ctx, req.Plan, req.State, req.ConfigValue, req.PlanValue, req.StateValue)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = value.(types.String)
+}
+
+func (m templateDefaultModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	value, diags := m.plannedValue(ctx, req.Plan, req.State, req.ConfigValue, req.PlanValue, req.StateValue)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = value.(types.Int64)
+}
+
+func (m templateDefaultModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	value, diags := m.plannedValue(ctx, req.Plan, req.State, req.ConfigValue, req.PlanValue, req.StateValue)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = value.(types.Map)
+}
+
+func (m templateDefaultModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	value, diags := m.plannedValue(ctx, req.Plan, req.State, req.ConfigValue, req.PlanValue, req.StateValue)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = value.(types.List)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTemplateDefaultModifier(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"template_id": schema.Int64Attribute{Optional: true},
+			"image_id":    schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+	value := func(templateID *int64, imageID interface{}) tftypes.Value {
+		var template interface{}
+		if templateID != nil {
+			template = *templateID
+		}
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"template_id": tftypes.NewValue(tftypes.Number, template),
+			"image_id":    tftypes.NewValue(tftypes.String, imageID),
+		})
+	}
+
+	tests := []struct {
+		name       string
+		templateID *int64
+		state      types.String
+		config     types.String
+		want       types.String
+	}{
+		{"removed without template", nil, types.StringValue("ubuntu-noble"), types.StringNull(), types.StringNull()},
+		{"unset with template", PtrTo(int64(12)), types.StringValue("ubuntu-noble"), types.StringNull(), types.StringValue("ubuntu-noble")},
+		{"configured", nil, types.StringValue("ubuntu-noble"), types.StringValue("debian-12"), types.StringValue("debian-12")},
+		{"created", PtrTo(int64(12)), types.StringNull(), types.StringNull(), types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned := tt.config
+			if planned.IsNull() {
+				planned = types.StringUnknown()
+			}
+
+			state := tftypes.NewValue(objectType, nil)
+			if !tt.state.IsNull() {
+				state = value(tt.templateID, tt.state.ValueString())
+			}
+
+			req := planmodifier.StringRequest{
+				Path:        path.Root("image_id"),
+				Plan:        tfsdk.Plan{Schema: s, Raw: value(tt.templateID, tftypes.UnknownValue)},
+				State:       tfsdk.State{Schema: s, Raw: state},
+				ConfigValue: tt.config,
+				PlanValue:   planned,
+				StateValue:  tt.state,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			useStateForTemplateDefault().PlanModifyString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Fatalf("expected %s, got %s", tt.want, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetalTemplatesDataSource{}
+
+func NewMetalTemplatesDataSource() datasource.DataSource {
+	return &MetalTemplatesDataSource{}
+}
+
+// MetalTemplatesDataSource defines the data source implementation.
+type MetalTemplatesDataSource struct {
+	providerData *ProviderData
+}
+
+// MetalTemplateModel describes a single metal template.
+type MetalTemplateModel struct {
+	ID          types.Int64                    `tfsdk:"id"`
+	ProjectID   types.Int64                    `tfsdk:"project_id"`
+	DisplayName types.String                   `tfsdk:"display_name"`
+	CloudInit   types.String                   `tfsdk:"cloud_init"`
+	Created     types.String                   `tfsdk:"created"`
+	CreateModel *MetalTemplateCreateModelModel `tfsdk:"create_model"`
+}
+
+// MetalTemplateCreateModelModel describes the server configuration that a
+// metal template deploys.
+type MetalTemplateCreateModelModel struct {
+	RegionID       types.String          `tfsdk:"region_id"`
+	TierID         types.String          `tfsdk:"tier_id"`
+	ImageID        types.String          `tfsdk:"image_id"`
+	MemoryGB       types.Int64           `tfsdk:"memory_gb"`
+	Disks          types.Map             `tfsdk:"disks"`
+	Partitions     []MetalPartitionModel `tfsdk:"partitions"`
+	RaidArrays     []MetalRaidArrayModel `tfsdk:"raid_arrays"`
+	SSHKeyIDs      types.List            `tfsdk:"ssh_key_ids"`
+	UserData       types.String          `tfsdk:"user_data"`
+	IPXEURL        types.String          `tfsdk:"ipxe_url"`
+	ReservePricing types.Bool            `tfsdk:"reserve_pricing"`
+	Tags           types.List            `tfsdk:"tags"`
+}
+
+// MetalTemplatesDataSourceModel describes the data source data model.
+type MetalTemplatesDataSourceModel struct {
+	ProjectID types.Int64          `tfsdk:"project_id"`
+	Templates []MetalTemplateModel `tfsdk:"templates"`
+}
+
+func (d *MetalTemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metal_templates"
+}
+
+func (d *MetalTemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Metal templates data source allows you to list the templates that can be deployed with the `template_id` of `teraswitch_metal`.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter templates by project. Templates that don't belong to a project are always included. Defaults to the provider `project_id` if one is set.",
+				Optional:            true,
+			},
+			"templates": schema.ListNestedAttribute{
+				MarkdownDescription: "List of metal templates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the template, for use as `template_id`.",
+							Computed:            true,
+						},
+						"project_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the project that the template belongs to.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the template.",
+							Computed:            true,
+						},
+						"cloud_init": schema.StringAttribute{
+							MarkdownDescription: "The cloud-init configuration applied by the template.",
+							Computed:            true,
+						},
+						"created": schema.StringAttribute{
+							MarkdownDescription: "The date when the template was created.",
+							Computed:            true,
+						},
+						"create_model": schema.SingleNestedAttribute{
+							MarkdownDescription: "The server configuration deployed by the template. `teraswitch_metal` uses these values for attributes left unset when `template_id` is set.",
+							Computed:            true,
+							Attributes:          metalTemplateCreateModelAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// metalTemplateCreateModelAttributes returns the computed attributes
+// describing a template's server configuration.
+func metalTemplateCreateModelAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"region_id": schema.StringAttribute{
+			MarkdownDescription: "The region the template deploys to.",
+			Computed:            true,
+		},
+		"tier_id": schema.StringAttribute{
+			MarkdownDescription: "The metal tier the template deploys.",
+			Computed:            true,
+		},
+		"image_id": schema.StringAttribute{
+			MarkdownDescription: "The image the template installs.",
+			Computed:            true,
+		},
+		"memory_gb": schema.Int64Attribute{
+			MarkdownDescription: "The amount of memory in GB.",
+			Computed:            true,
+		},
+		"disks": schema.MapAttribute{
+			MarkdownDescription: "Dictionary of disk names and sizes.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"partitions": schema.ListNestedAttribute{
+			MarkdownDescription: "Partitions created by the template.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the partition.",
+						Computed:            true,
+					},
+					"device": schema.StringAttribute{
+						MarkdownDescription: "The device or RAID array the partition is created on.",
+						Computed:            true,
+					},
+					"size_bytes": schema.Int64Attribute{
+						MarkdownDescription: "The size of the partition in bytes. Null if it uses the remaining space.",
+						Computed:            true,
+					},
+					"file_system": schema.StringAttribute{
+						MarkdownDescription: "The filesystem of the partition.",
+						Computed:            true,
+					},
+					"mount_point": schema.StringAttribute{
+						MarkdownDescription: "The mount point of the partition.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"raid_arrays": schema.ListNestedAttribute{
+			MarkdownDescription: "RAID arrays created by the template.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the RAID array.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the RAID array.",
+						Computed:            true,
+					},
+					"members": schema.ListAttribute{
+						MarkdownDescription: "The devices or partitions in the RAID array.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"file_system": schema.StringAttribute{
+						MarkdownDescription: "The filesystem of the RAID array.",
+						Computed:            true,
+					},
+					"mount_point": schema.StringAttribute{
+						MarkdownDescription: "The mount point of the RAID array.",
+						Computed:            true,
+					},
+					"size_bytes": schema.Int64Attribute{
+						MarkdownDescription: "The size of the RAID array in bytes.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"ssh_key_ids": schema.ListAttribute{
+			MarkdownDescription: "The SSH key IDs added to the server.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"user_data": schema.StringAttribute{
+			MarkdownDescription: "Additional user data.",
+			Computed:            true,
+		},
+		"ipxe_url": schema.StringAttribute{
+			MarkdownDescription: "The iPXE script URL.",
+			Computed:            true,
+		},
+		"reserve_pricing": schema.BoolAttribute{
+			MarkdownDescription: "Whether the server is reserved for a year at the discounted rate.",
+			Computed:            true,
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "Tags added to the server.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+func (d *MetalTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *MetalTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetalTemplatesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, diags := projectIDFilter(data.ProjectID, d.providerData.projectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := listMetalTemplates(ctx, d.providerData.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metal templates, got error: %s", err))
+		return
+	}
+
+	data.Templates = []MetalTemplateModel{}
+	for _, template := range templates {
+		if projectID != nil && derefOr(template.ProjectId, 0) != 0 && *template.ProjectId != int64(*projectID) {
+			continue
+		}
+
+		model, diags := newMetalTemplateModel(ctx, template)
+		resp.Diagnostics.Append(diags...)
+		data.Templates = append(data.Templates, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read metal templates data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newMetalTemplateModel(ctx context.Context, template client.MetalTemplate) (MetalTemplateModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := MetalTemplateModel{
+		ID:          types.Int64PointerValue(template.Id),
+		ProjectID:   types.Int64PointerValue(template.ProjectId),
+		DisplayName: types.StringPointerValue(template.DisplayName),
+		CloudInit:   types.StringPointerValue(template.CloudInit),
+		Created:     types.StringPointerValue(template.Created),
+	}
+
+	if template.CreateModel == nil {
+		return model, diags
+	}
+
+	create := template.CreateModel
+	defaults, d := newMetalTemplateDefaults(ctx, *create)
+	diags.Append(d...)
+
+	model.CreateModel = &MetalTemplateCreateModelModel{
+		RegionID:       types.StringValue(create.RegionId),
+		TierID:         defaults.TierID,
+		ImageID:        defaults.ImageID,
+		MemoryGB:       defaults.MemoryGB,
+		Disks:          defaults.Disks,
+		Partitions:     defaults.Partitions,
+		RaidArrays:     defaults.RaidArrays,
+		UserData:       types.StringPointerValue(create.UserData),
+		IPXEURL:        types.StringPointerValue(create.IpxeUrl),
+		ReservePricing: types.BoolPointerValue(create.ReservePricing),
+	}
+	if create.RegionId == "" {
+		model.CreateModel.RegionID = types.StringNull()
+	}
+
+	model.CreateModel.SSHKeyIDs, d = types.ListValueFrom(ctx, types.Int64Type, create.SshKeyIds)
+	diags.Append(d...)
+	model.CreateModel.Tags, d = types.ListValueFrom(ctx, types.StringType, create.Tags)
+	diags.Append(d...)
+
+	return model, diags
+}
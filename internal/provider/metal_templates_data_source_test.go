@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMetalTemplateModel(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := newMetalTemplateModel(ctx, client.MetalTemplate{
+		Id:          PtrTo(int64(7)),
+		DisplayName: PtrTo("k8s node"),
+		CreateModel: &client.CreateMetalRequest{
+			TierId:   "7950x",
+			ImageId:  PtrTo("ubuntu-noble"),
+			MemoryGb: PtrTo(int32(128)),
+			Disks: &map[string]*string{
+				"nvme0n1": PtrTo("1.92t"),
+			},
+			Partitions: &[]client.Partition{
+				{Name: PtrTo("nvme0n1-part1"), Device: PtrTo("nvme0n1"), FileSystem: PtrTo(client.FileSystemExt4), MountPoint: PtrTo("/")},
+			},
+		},
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	require.Equal(t, int64(7), model.ID.ValueInt64())
+	require.NotNil(t, model.CreateModel)
+	require.True(t, model.CreateModel.RegionID.IsNull())
+	require.Equal(t, "7950x", model.CreateModel.TierID.ValueString())
+	require.Equal(t, int64(128), model.CreateModel.MemoryGB.ValueInt64())
+	require.Len(t, model.CreateModel.Disks.Elements(), 1)
+	require.Len(t, model.CreateModel.Partitions, 1)
+	require.Equal(t, "Ext4", model.CreateModel.Partitions[0].FileSystem.ValueString())
+	require.True(t, model.CreateModel.Partitions[0].SizeBytes.IsNull())
+	require.Nil(t, model.CreateModel.RaidArrays)
+	require.True(t, model.CreateModel.SSHKeyIDs.IsNull())
+}
+
+func TestAccMetalTemplatesDataSource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "teraswitch" {}
+
+data "teraswitch_metal_templates" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal_templates.test", "templates.#"),
+				),
+			},
+		},
+	})
+}
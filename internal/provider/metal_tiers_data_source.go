@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,16 +30,57 @@ type MetalTiersDataSource struct {
 
 // MetalTierModel describes a single metal tier.
 type MetalTierModel struct {
-	ID             types.String  `tfsdk:"id"`
-	CPU            types.String  `tfsdk:"cpu"`
-	CPUDescription types.String  `tfsdk:"cpu_description"`
-	HourlyPrice    types.Float64 `tfsdk:"hourly_price"`
-	MonthlyPrice   types.Float64 `tfsdk:"monthly_price"`
+	ID             types.String              `tfsdk:"id"`
+	CPU            types.String              `tfsdk:"cpu"`
+	CPUDescription types.String              `tfsdk:"cpu_description"`
+	HourlyPrice    types.Float64             `tfsdk:"hourly_price"`
+	MonthlyPrice   types.Float64             `tfsdk:"monthly_price"`
+	Hidden         types.Bool                `tfsdk:"hidden"`
+	DriveSlots     []MetalDriveSlotModel     `tfsdk:"drive_slots"`
+	DefaultDisks   types.Map                 `tfsdk:"default_disks"`
+	MemoryOptions  []MetalMemoryOptionModel  `tfsdk:"memory_options"`
+	NetworkOptions []MetalNetworkOptionModel `tfsdk:"network_options"`
+	Availability   types.Map                 `tfsdk:"availability"`
+}
+
+// MetalDriveSlotModel describes a drive slot of a metal tier.
+type MetalDriveSlotModel struct {
+	ID      types.String            `tfsdk:"id"`
+	Default types.String            `tfsdk:"default"`
+	Options []MetalDriveOptionModel `tfsdk:"options"`
+}
+
+// MetalDriveOptionModel describes a drive that can be placed in a drive slot.
+type MetalDriveOptionModel struct {
+	Name         types.String  `tfsdk:"name"`
+	CapacityGB   types.Int64   `tfsdk:"capacity_gb"`
+	Type         types.String  `tfsdk:"type"`
+	HourlyPrice  types.Float64 `tfsdk:"hourly_price"`
+	MonthlyPrice types.Float64 `tfsdk:"monthly_price"`
+}
+
+// MetalMemoryOptionModel describes a memory option of a metal tier.
+type MetalMemoryOptionModel struct {
+	GB           types.Int64   `tfsdk:"gb"`
+	Default      types.Bool    `tfsdk:"default"`
+	HourlyPrice  types.Float64 `tfsdk:"hourly_price"`
+	MonthlyPrice types.Float64 `tfsdk:"monthly_price"`
+}
+
+// MetalNetworkOptionModel describes a network option of a metal tier.
+type MetalNetworkOptionModel struct {
+	SpeedGbps    types.Int64   `tfsdk:"speed_gbps"`
+	Default      types.Bool    `tfsdk:"default"`
+	HourlyPrice  types.Float64 `tfsdk:"hourly_price"`
+	MonthlyPrice types.Float64 `tfsdk:"monthly_price"`
 }
 
 // MetalTiersDataSourceModel describes the data source data model.
 type MetalTiersDataSourceModel struct {
-	Tiers []MetalTierModel `tfsdk:"tiers"`
+	Region          types.String     `tfsdk:"region"`
+	MinMemoryGB     types.Int64      `tfsdk:"min_memory_gb"`
+	MaxMonthlyPrice types.Float64    `tfsdk:"max_monthly_price"`
+	Tiers           []MetalTierModel `tfsdk:"tiers"`
 }
 
 func (d *MetalTiersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,9 +89,27 @@ func (d *MetalTiersDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 func (d *MetalTiersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Metal Tiers data source allows you to retrieve all available metal server tiers with pricing.",
+		MarkdownDescription: "Metal Tiers data source allows you to retrieve all available metal server tiers with pricing, drive, memory and network options.",
 
 		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only return tiers that are in stock in this region.",
+				Optional:            true,
+			},
+			"min_memory_gb": schema.Int64Attribute{
+				MarkdownDescription: "Only return tiers offering a memory option of at least this many GB.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_monthly_price": schema.Float64Attribute{
+				MarkdownDescription: "Only return tiers whose monthly price is at most this amount. With `min_memory_gb` set, the price includes the cheapest memory option of at least that size.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
 			"tiers": schema.ListNestedAttribute{
 				MarkdownDescription: "List of available metal tiers.",
 				Computed:            true,
@@ -71,6 +135,112 @@ func (d *MetalTiersDataSource) Schema(ctx context.Context, req datasource.Schema
 							MarkdownDescription: "The monthly price for the tier.",
 							Computed:            true,
 						},
+						"hidden": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tier is hidden from the tier listing.",
+							Computed:            true,
+						},
+						"drive_slots": schema.ListNestedAttribute{
+							MarkdownDescription: "The drive slots of the tier and the drives that can be placed in them. Slot IDs are the keys of the metal `disks` attribute.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the drive slot (e.g., nvme0n1).",
+										Computed:            true,
+									},
+									"default": schema.StringAttribute{
+										MarkdownDescription: "The name of the drive installed in the slot by default, if any.",
+										Computed:            true,
+									},
+									"options": schema.ListNestedAttribute{
+										MarkdownDescription: "The drives that can be installed in the slot.",
+										Computed:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													MarkdownDescription: "The name of the drive, used as a value of the metal `disks` attribute (e.g., 1.92t).",
+													Computed:            true,
+												},
+												"capacity_gb": schema.Int64Attribute{
+													MarkdownDescription: "The capacity of the drive in GB.",
+													Computed:            true,
+												},
+												"type": schema.StringAttribute{
+													MarkdownDescription: "The storage type of the drive: HDD, SSD or NVME.",
+													Computed:            true,
+												},
+												"hourly_price": schema.Float64Attribute{
+													MarkdownDescription: "The hourly price for the drive.",
+													Computed:            true,
+												},
+												"monthly_price": schema.Float64Attribute{
+													MarkdownDescription: "The monthly price for the drive.",
+													Computed:            true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"default_disks": schema.MapAttribute{
+							MarkdownDescription: "The default drive of each slot that has one, in the format of the metal `disks` attribute.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"memory_options": schema.ListNestedAttribute{
+							MarkdownDescription: "The memory options of the tier, usable as the metal `memory_gb` attribute.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"gb": schema.Int64Attribute{
+										MarkdownDescription: "The amount of memory in GB.",
+										Computed:            true,
+									},
+									"default": schema.BoolAttribute{
+										MarkdownDescription: "Whether this is the default memory option for the tier.",
+										Computed:            true,
+									},
+									"hourly_price": schema.Float64Attribute{
+										MarkdownDescription: "The hourly price for the memory option.",
+										Computed:            true,
+									},
+									"monthly_price": schema.Float64Attribute{
+										MarkdownDescription: "The monthly price for the memory option.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"network_options": schema.ListNestedAttribute{
+							MarkdownDescription: "The network options of the tier.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"speed_gbps": schema.Int64Attribute{
+										MarkdownDescription: "The speed of the network in Gbps.",
+										Computed:            true,
+									},
+									"default": schema.BoolAttribute{
+										MarkdownDescription: "Whether this is the default network option for the tier.",
+										Computed:            true,
+									},
+									"hourly_price": schema.Float64Attribute{
+										MarkdownDescription: "The hourly price for the network option.",
+										Computed:            true,
+									},
+									"monthly_price": schema.Float64Attribute{
+										MarkdownDescription: "The monthly price for the network option.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"availability": schema.MapAttribute{
+							MarkdownDescription: "The number of servers of the tier that can currently be deployed, keyed by region ID.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
 					},
 				},
 			},
@@ -111,55 +281,173 @@ func (d *MetalTiersDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to read metal tiers, got error: %s", string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("read metal tiers", res.StatusCode(), res.Body, req.Config.Raw)...)
 		return
 	}
 
-	if res.JSON200 == nil || res.JSON200.Result == nil {
-		data.Tiers = []MetalTierModel{}
-	} else {
-		tiers := make([]MetalTierModel, 0, len(*res.JSON200.Result))
-		for _, tier := range *res.JSON200.Result {
-			tierModel := MetalTierModel{}
+	filter := metalTierFilter{
+		Region:          data.Region.ValueString(),
+		MinMemoryGB:     data.MinMemoryGB.ValueInt64(),
+		MaxMonthlyPrice: data.MaxMonthlyPrice.ValueFloat64Pointer(),
+	}
 
-			if tier.Id != nil {
-				tierModel.ID = types.StringValue(*tier.Id)
-			} else {
-				tierModel.ID = types.StringNull()
+	data.Tiers = []MetalTierModel{}
+	if res.JSON200 != nil && res.JSON200.Result != nil {
+		for _, tier := range *res.JSON200.Result {
+			if !filter.matches(tier) {
+				continue
 			}
+			tierModel, diags := newMetalTierModel(ctx, tier)
+			resp.Diagnostics.Append(diags...)
+			data.Tiers = append(data.Tiers, tierModel)
+		}
+	}
 
-			if tier.Cpu != nil {
-				tierModel.CPU = types.StringValue(*tier.Cpu)
-			} else {
-				tierModel.CPU = types.StringNull()
-			}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if tier.CpuDescription != nil {
-				tierModel.CPUDescription = types.StringValue(*tier.CpuDescription)
-			} else {
-				tierModel.CPUDescription = types.StringNull()
+	tflog.Trace(ctx, "read metal tiers data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// metalTierFilter selects metal tiers. Zero values don't filter.
+type metalTierFilter struct {
+	Region          string
+	MinMemoryGB     int64
+	MaxMonthlyPrice *float64
+}
+
+func (f metalTierFilter) matches(tier client.MetalTier) bool {
+	if f.Region != "" {
+		if tier.Availability == nil {
+			return false
+		}
+		availability, ok := (*tier.Availability)[f.Region]
+		if !ok || derefOr(availability.MaxQuantity, 0) <= 0 {
+			return false
+		}
+	}
+
+	// The price of the memory needed to meet MinMemoryGB
+	var memoryPrice float64
+	if f.MinMemoryGB > 0 {
+		found := false
+		if tier.MemoryOptions != nil {
+			for _, option := range *tier.MemoryOptions {
+				if int64(derefOr(option.Gb, 0)) < f.MinMemoryGB {
+					continue
+				}
+				if price := derefOr(option.MonthlyPrice, 0); !found || price < memoryPrice {
+					memoryPrice = price
+				}
+				found = true
 			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.MaxMonthlyPrice != nil && (tier.MonthlyPrice == nil || *tier.MonthlyPrice+memoryPrice > *f.MaxMonthlyPrice) {
+		return false
+	}
+
+	return true
+}
 
-			if tier.HourlyPrice != nil {
-				tierModel.HourlyPrice = types.Float64Value(*tier.HourlyPrice)
-			} else {
-				tierModel.HourlyPrice = types.Float64Null()
+func newMetalTierModel(ctx context.Context, tier client.MetalTier) (MetalTierModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	m := MetalTierModel{
+		ID:             types.StringPointerValue(tier.Id),
+		CPU:            types.StringPointerValue(tier.Cpu),
+		CPUDescription: types.StringPointerValue(tier.CpuDescription),
+		HourlyPrice:    types.Float64PointerValue(tier.HourlyPrice),
+		MonthlyPrice:   types.Float64PointerValue(tier.MonthlyPrice),
+		Hidden:         types.BoolValue(derefOr(tier.Hidden, false)),
+		DriveSlots:     []MetalDriveSlotModel{},
+		MemoryOptions:  []MetalMemoryOptionModel{},
+		NetworkOptions: []MetalNetworkOptionModel{},
+	}
+
+	defaultDisks := map[string]string{}
+	if tier.DriveSlots != nil {
+		for _, slot := range *tier.DriveSlots {
+			slotModel := MetalDriveSlotModel{
+				ID:      types.StringPointerValue(slot.Id),
+				Default: types.StringPointerValue(slot.Default),
+				Options: []MetalDriveOptionModel{},
+			}
+			if slot.Id != nil && derefOr(slot.Default, "") != "" {
+				defaultDisks[*slot.Id] = *slot.Default
+			}
+			if slot.Options != nil {
+				for _, option := range *slot.Options {
+					optionModel := MetalDriveOptionModel{
+						Name:         types.StringPointerValue(option.Name),
+						CapacityGB:   types.Int64Null(),
+						Type:         types.StringNull(),
+						HourlyPrice:  types.Float64PointerValue(option.HourlyPrice),
+						MonthlyPrice: types.Float64PointerValue(option.MonthlyPrice),
+					}
+					if option.CapacityGb != nil {
+						optionModel.CapacityGB = types.Int64Value(int64(*option.CapacityGb))
+					}
+					if option.Type != nil {
+						optionModel.Type = types.StringValue(string(*option.Type))
+					}
+					slotModel.Options = append(slotModel.Options, optionModel)
+				}
 			}
+			m.DriveSlots = append(m.DriveSlots, slotModel)
+		}
+	}
 
-			if tier.MonthlyPrice != nil {
-				tierModel.MonthlyPrice = types.Float64Value(*tier.MonthlyPrice)
-			} else {
-				tierModel.MonthlyPrice = types.Float64Null()
+	defaultDisksMap, d := types.MapValueFrom(ctx, types.StringType, defaultDisks)
+	diags.Append(d...)
+	m.DefaultDisks = defaultDisksMap
+
+	if tier.MemoryOptions != nil {
+		for _, option := range *tier.MemoryOptions {
+			optionModel := MetalMemoryOptionModel{
+				GB:           types.Int64Null(),
+				Default:      types.BoolValue(derefOr(option.Default, false)),
+				HourlyPrice:  types.Float64PointerValue(option.HourlyPrice),
+				MonthlyPrice: types.Float64PointerValue(option.MonthlyPrice),
+			}
+			if option.Gb != nil {
+				optionModel.GB = types.Int64Value(int64(*option.Gb))
 			}
+			m.MemoryOptions = append(m.MemoryOptions, optionModel)
+		}
+	}
 
-			tiers = append(tiers, tierModel)
+	if tier.NetworkOptions != nil {
+		for _, option := range *tier.NetworkOptions {
+			optionModel := MetalNetworkOptionModel{
+				SpeedGbps:    types.Int64Null(),
+				Default:      types.BoolValue(derefOr(option.Default, false)),
+				HourlyPrice:  types.Float64PointerValue(option.HourlyPrice),
+				MonthlyPrice: types.Float64PointerValue(option.MonthlyPrice),
+			}
+			if option.SpeedGbps != nil {
+				optionModel.SpeedGbps = types.Int64Value(int64(*option.SpeedGbps))
+			}
+			m.NetworkOptions = append(m.NetworkOptions, optionModel)
 		}
-		data.Tiers = tiers
 	}
 
-	tflog.Trace(ctx, "read metal tiers data source")
+	availability := map[string]int64{}
+	if tier.Availability != nil {
+		for region, a := range *tier.Availability {
+			availability[region] = int64(derefOr(a.MaxQuantity, 0))
+		}
+	}
+	availabilityMap, d := types.MapValueFrom(ctx, types.Int64Type, availability)
+	diags.Append(d...)
+	m.Availability = availabilityMap
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return m, diags
 }
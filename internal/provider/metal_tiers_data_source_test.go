@@ -4,9 +4,53 @@ import (
 	"os"
 	"testing"
 
+	"github.com/TeraSwitch/terraform-provider/client"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func TestMetalTierFilter(t *testing.T) {
+	tier := client.MetalTier{
+		Id:           PtrTo("7950x"),
+		MonthlyPrice: PtrTo(299.0),
+		MemoryOptions: &[]client.MemoryOption{
+			{Gb: PtrTo(int32(64)), Default: PtrTo(true)},
+			{Gb: PtrTo(int32(128)), MonthlyPrice: PtrTo(100.0)},
+			{Gb: PtrTo(int32(256)), MonthlyPrice: PtrTo(250.0)},
+		},
+		Availability: &map[string]client.ServiceAvailability{
+			"SLC1": {MaxQuantity: PtrTo(int32(3))},
+			"PIT1": {MaxQuantity: PtrTo(int32(0))},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter metalTierFilter
+		want   bool
+	}{
+		{"no filter", metalTierFilter{}, true},
+		{"in stock", metalTierFilter{Region: "SLC1"}, true},
+		{"out of stock", metalTierFilter{Region: "PIT1"}, false},
+		{"unlisted region", metalTierFilter{Region: "LAX1"}, false},
+		{"memory option available", metalTierFilter{MinMemoryGB: 128}, true},
+		{"memory option unavailable", metalTierFilter{MinMemoryGB: 512}, false},
+		{"within budget", metalTierFilter{MaxMonthlyPrice: PtrTo(299.0)}, true},
+		{"over budget", metalTierFilter{MaxMonthlyPrice: PtrTo(250.0)}, false},
+		{"combined", metalTierFilter{Region: "SLC1", MinMemoryGB: 64, MaxMonthlyPrice: PtrTo(300.0)}, true},
+		{"memory within budget", metalTierFilter{MinMemoryGB: 128, MaxMonthlyPrice: PtrTo(399.0)}, true},
+		{"memory over budget", metalTierFilter{MinMemoryGB: 128, MaxMonthlyPrice: PtrTo(350.0)}, false},
+		{"cheapest qualifying memory", metalTierFilter{MinMemoryGB: 100, MaxMonthlyPrice: PtrTo(399.0)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(tier); got != tt.want {
+				t.Fatalf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
 func TestAccMetalTiersDataSource(t *testing.T) {
 	if os.Getenv("TERASWITCH_API_KEY") == "" {
 		t.Skip("Skipping, api key not provided")
@@ -21,6 +65,9 @@ func TestAccMetalTiersDataSource(t *testing.T) {
 				Config: testAccMetalTiersDataSourceConfig(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.teraswitch_metal_tiers.test", "tiers.#"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal_tiers.test", "tiers.0.drive_slots.#"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal_tiers.test", "tiers.0.memory_options.#"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_metal_tiers.filtered", "tiers.#"),
 				),
 			},
 		},
@@ -32,5 +79,10 @@ func testAccMetalTiersDataSourceConfig() string {
 provider "teraswitch" {}
 
 data "teraswitch_metal_tiers" "test" {}
+
+data "teraswitch_metal_tiers" "filtered" {
+  region        = "PIT1"
+  min_memory_gb = 64
+}
 `
 }
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetalsDataSource{}
+
+// metalsPageSize is the number of metal services requested per page.
+const metalsPageSize = 100
+
+func NewMetalsDataSource() datasource.DataSource {
+	return &MetalsDataSource{}
+}
+
+// MetalsDataSource defines the data source implementation.
+type MetalsDataSource struct {
+	providerData *ProviderData
+}
+
+// MetalsItemModel describes a single metal service in the list.
+type MetalsItemModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	RegionID    types.String `tfsdk:"region_id"`
+	TierID      types.String `tfsdk:"tier_id"`
+	Status      types.String `tfsdk:"status"`
+	PowerState  types.String `tfsdk:"power_state"`
+	IPAddresses types.List   `tfsdk:"ip_addresses"`
+	Tags        types.List   `tfsdk:"tags"`
+}
+
+// MetalsDataSourceModel describes the data source data model.
+type MetalsDataSourceModel struct {
+	ProjectID types.Int64       `tfsdk:"project_id"`
+	Status    types.String      `tfsdk:"status"`
+	Region    types.String      `tfsdk:"region"`
+	Tier      types.String      `tfsdk:"tier"`
+	Tag       types.String      `tfsdk:"tag"`
+	Metals    []MetalsItemModel `tfsdk:"metals"`
+}
+
+func (d *MetalsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metals"
+}
+
+func (d *MetalsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Metals data source allows you to list all metal services matching the given filters.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter metal services by project. Defaults to the provider `project_id` if one is set.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Filter metal services by status. Valid values are: Active, Pending, Suspended, Terminated.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Active", "Pending", "Suspended", "Terminated"),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Filter metal services by region ID. May be comma separated to include multiple regions.",
+				Optional:            true,
+			},
+			"tier": schema.StringAttribute{
+				MarkdownDescription: "Filter metal services by tier ID. May be comma separated to include multiple tiers.",
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Filter metal services by tag. May be comma separated to include multiple tags.",
+				Optional:            true,
+			},
+			"metals": schema.ListNestedAttribute{
+				MarkdownDescription: "List of metal services matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the metal service.",
+							Computed:            true,
+						},
+						"project_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the project that the metal service belongs to.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the metal service.",
+							Computed:            true,
+						},
+						"region_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the region where the metal service is located.",
+							Computed:            true,
+						},
+						"tier_id": schema.StringAttribute{
+							MarkdownDescription: "The service tier of the metal service.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the metal service.",
+							Computed:            true,
+						},
+						"power_state": schema.StringAttribute{
+							MarkdownDescription: "The power state of the metal service.",
+							Computed:            true,
+						},
+						"ip_addresses": schema.ListAttribute{
+							MarkdownDescription: "The IP addresses associated with the metal service.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "Tags associated with the metal service.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MetalsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *MetalsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetalsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.GetV2MetalParams{
+		Status: data.Status.ValueStringPointer(),
+		Region: data.Region.ValueStringPointer(),
+		Tier:   data.Tier.ValueStringPointer(),
+		Tag:    data.Tag.ValueStringPointer(),
+		Limit:  PtrTo(int32(metalsPageSize)),
+	}
+
+	projectID, diags := projectIDFilter(data.ProjectID, d.providerData.projectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.ProjectId = projectID
+
+	metals := []MetalsItemModel{}
+	for skip := int32(0); ; {
+		params.Skip = PtrTo(skip)
+
+		res, err := d.providerData.client.GetV2MetalWithResponse(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metal services, got error: %s", err))
+			return
+		}
+
+		if res.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(apiErrorDiagnostics("read metal services", res.StatusCode(), res.Body, req.Config.Raw)...)
+			return
+		}
+
+		if res.JSON200 == nil || res.JSON200.Result == nil || len(*res.JSON200.Result) == 0 {
+			break
+		}
+
+		for _, service := range *res.JSON200.Result {
+			item, diags := newMetalsItemModel(ctx, service)
+			resp.Diagnostics.Append(diags...)
+			metals = append(metals, item)
+		}
+
+		skip += int32(len(*res.JSON200.Result))
+
+		tflog.Debug(ctx, "read metal services page", map[string]interface{}{
+			"count": len(metals),
+		})
+
+		meta := res.JSON200.Metadata
+		if meta != nil && meta.TotalCount != nil {
+			if skip >= *meta.TotalCount {
+				break
+			}
+		} else if len(*res.JSON200.Result) < metalsPageSize {
+			break
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Metals = metals
+
+	tflog.Trace(ctx, "read metals data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newMetalsItemModel(ctx context.Context, service client.MetalService) (MetalsItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item := MetalsItemModel{
+		ID:          types.Int64PointerValue(service.Id),
+		ProjectID:   types.Int64PointerValue(service.ProjectId),
+		DisplayName: types.StringPointerValue(service.DisplayName),
+		RegionID:    types.StringPointerValue(service.RegionId),
+		TierID:      types.StringPointerValue(service.TierId),
+		Status:      types.StringPointerValue(service.Status),
+		PowerState:  types.StringPointerValue(service.PowerState),
+		IPAddresses: types.ListNull(types.StringType),
+		Tags:        types.ListNull(types.StringType),
+	}
+
+	if service.IpAddresses != nil {
+		ipList, d := types.ListValueFrom(ctx, types.StringType, *service.IpAddresses)
+		diags.Append(d...)
+		item.IPAddresses = ipList
+	}
+
+	if service.Tags != nil {
+		tagsList, d := types.ListValueFrom(ctx, types.StringType, *service.Tags)
+		diags.Append(d...)
+		item.Tags = tagsList
+	}
+
+	return item, diags
+}
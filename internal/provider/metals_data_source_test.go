@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetalsDataSource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetalsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teraswitch_metals.all", "metals.#"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_metals.active", "metals.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetalsDataSourceConfig() string {
+	return `
+provider "teraswitch" {}
+
+data "teraswitch_metals" "all" {}
+
+data "teraswitch_metals" "active" {
+  status = "Active"
+}
+`
+}
@@ -45,7 +45,7 @@ func (r *NetworkResource) Metadata(ctx context.Context, req resource.MetadataReq
 func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Network (DO NOT USE)",
+		MarkdownDescription: "Private network that cloud compute instances can be attached to.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -58,18 +58,27 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"region_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the region that the network will be created in",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"display_name": schema.StringAttribute{
 				MarkdownDescription: "The display name of the network. This is optional",
 				Optional:            true,
 			},
 			"v4_subnet": schema.StringAttribute{
-				MarkdownDescription: "The IPv4 subnet that the network will use",
+				MarkdownDescription: "The IPv4 network address that the network will use. For example: 10.99.0.0",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"v4_subnet_mask": schema.StringAttribute{
-				MarkdownDescription: "The IPv4 subnet mask that the network will use",
+				MarkdownDescription: "The number of bits for the IPv4 netmask in CIDR notation. For example: 24",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
@@ -119,9 +128,7 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Accept both 200 OK and 201 Created as success
 	if res.StatusCode() != http.StatusOK && res.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create network, got status %d: %s", res.StatusCode(), string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("create network", res.StatusCode(), res.Body, req.Plan.Raw)...)
 		return
 	}
 
@@ -164,20 +171,96 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	res, err := r.providerData.client.GetV2NetworkNetworkIdWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get network, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() == http.StatusNotFound {
+		// Resource no longer exists, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("get network", res.StatusCode(), res.Body, req.State.Raw)...)
+		return
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		resp.Diagnostics.AddError("Client Error", "Network not found")
+		return
+	}
+	network := res.JSON200.Result
+
+	if network.Id != nil {
+		data.ID = types.StringValue(*network.Id)
+	}
+
+	if network.RegionId != nil {
+		data.RegionID = types.StringValue(*network.RegionId)
+	}
+
+	// display_name is optional, so don't turn an unset name into an empty one.
+	if network.DisplayName != nil && (*network.DisplayName != "" || !data.DisplayName.IsNull()) {
+		data.DisplayName = types.StringValue(*network.DisplayName)
+	}
+
+	if network.V4Subnet != nil {
+		data.V4Subnet = types.StringValue(*network.V4Subnet)
+	}
+
+	if network.V4SubnetMask != nil {
+		data.V4SubnetMask = types.StringValue(*network.V4SubnetMask)
+	}
+
+	tflog.Trace(ctx, "read network resource")
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data NetworkResourceModel
+	var plan NetworkResourceModel
+	var state NetworkResourceModel
 
 	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	// Read the current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// The display name is the only attribute that can be changed in place.
+	if !plan.DisplayName.Equal(state.DisplayName) {
+		tflog.Debug(ctx, "display name changed, updating...", map[string]interface{}{
+			"old_display_name": state.DisplayName.String(),
+			"new_display_name": plan.DisplayName.String(),
+		})
+
+		res, err := r.providerData.client.PutV2NetworkWithResponse(ctx, &client.PutV2NetworkParams{
+			NetworkId: state.ID.ValueStringPointer(),
+		}, client.PutV2NetworkJSONRequestBody{
+			DisplayName: PtrTo(plan.DisplayName.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update network, got error: %s", err))
+			return
+		}
+
+		if res.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(apiErrorDiagnostics("update network", res.StatusCode(), res.Body, req.Plan.Raw)...)
+			return
+		}
+		tflog.Trace(ctx, "display name updated")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -189,6 +272,26 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	res, err := r.providerData.client.DeleteV2NetworkWithResponse(ctx, &client.DeleteV2NetworkParams{
+		NetworkId: data.ID.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete network, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() == http.StatusNotFound {
+		// Network already deleted, nothing to do
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("delete network", res.StatusCode(), res.Body, req.State.Raw)...)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted network resource")
 }
 
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
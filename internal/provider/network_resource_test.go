@@ -2,14 +2,15 @@ package provider
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccNetworkResource(t *testing.T) {
-	if true {
-		t.Skip("Skipping, networks do not currently work")
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
 		return
 	}
 
@@ -19,29 +20,26 @@ func TestAccNetworkResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccExampleResourceConfig("PIT1"),
+				Config: testAccNetworkResourceConfig("yeehaw"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("teraswitch_network.test", "configurable_attribute", "one"),
-					resource.TestCheckResourceAttr("teraswitch_network.test", "defaulted", "example value when not configured"),
-					resource.TestCheckResourceAttr("teraswitch_network.test", "id", "example-id"),
+					resource.TestCheckResourceAttrSet("teraswitch_network.test", "id"),
+					resource.TestCheckResourceAttr("teraswitch_network.test", "region_id", "PIT1"),
+					resource.TestCheckResourceAttr("teraswitch_network.test", "display_name", "yeehaw"),
+					resource.TestCheckResourceAttr("teraswitch_network.test", "v4_subnet", "10.99.0.0"),
+					resource.TestCheckResourceAttr("teraswitch_network.test", "v4_subnet_mask", "24"),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName:      "scaffolding_example.test",
+				ResourceName:      "teraswitch_network.test",
 				ImportState:       true,
 				ImportStateVerify: true,
-				// This is not normally necessary, but is here because this
-				// example code does not have an actual upstream service.
-				// Once the Read method is able to refresh information from
-				// the upstream service, this can be removed.
-				ImportStateVerifyIgnore: []string{"configurable_attribute", "defaulted"},
 			},
 			// Update and Read testing
 			{
-				Config: testAccExampleResourceConfig("two"),
+				Config: testAccNetworkResourceConfig("yeehaw2"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("scaffolding_example.test", "configurable_attribute", "two"),
+					resource.TestCheckResourceAttr("teraswitch_network.test", "display_name", "yeehaw2"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -49,15 +47,15 @@ func TestAccNetworkResource(t *testing.T) {
 	})
 }
 
-func testAccExampleResourceConfig(configurableAttribute string) string {
+func testAccNetworkResourceConfig(displayName string) string {
 	return fmt.Sprintf(`
 provider "teraswitch" {}
 
 resource "teraswitch_network" "test" {
-  region_id      = %[1]q
-  display_name   = "yeehaw"
+  region_id      = "PIT1"
+  display_name   = %[1]q
   v4_subnet      = "10.99.0.0"
   v4_subnet_mask = "24"
 }
-`, configurableAttribute)
+`, displayName)
 }
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Service types accepted by the price calculator.
+const (
+	serviceTypeMetal    = "Metal"
+	serviceTypeInstance = "Instance"
+)
+
+// calculatePrice returns the price breakdown of a service configuration.
+func calculatePrice(ctx context.Context, c *client.ClientWithResponses, body client.CalculatePriceRequest) (*client.CalculatePriceResponse, error) {
+	res, err := c.PostV2PriceCalculateWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("calculate price: %w", newAPIError(res.StatusCode(), res.Body))
+	}
+
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("calculate price: empty response from API")
+	}
+
+	return res.JSON200, nil
+}
+
+// planPriceEstimate sets the estimated_hourly_price and
+// estimated_monthly_price attributes of a planned resource. When body is nil
+// (the configuration isn't fully known yet) or the price can't be calculated,
+// the estimates are left unknown. The returned price is nil in those cases.
+func planPriceEstimate(ctx context.Context, c *client.ClientWithResponses, plan *tfsdk.Plan, body *client.CalculatePriceRequest) (*client.CalculatePriceResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hourly, monthly := types.Float64Unknown(), types.Float64Unknown()
+
+	var price *client.CalculatePriceResponse
+	if body != nil {
+		var err error
+		price, err = calculatePrice(ctx, c, *body)
+		if err != nil {
+			tflog.Warn(ctx, "unable to estimate price", map[string]interface{}{
+				"error": err.Error(),
+			})
+		} else {
+			hourly = types.Float64PointerValue(price.HourlyPrice)
+			monthly = types.Float64PointerValue(price.MonthlyPrice)
+		}
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("estimated_hourly_price"), hourly)...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("estimated_monthly_price"), monthly)...)
+
+	return price, diags
+}
+
+// resolvePriceEstimate replaces estimates that stayed unknown during planning
+// with null, since computed values must be known once applied.
+func resolvePriceEstimate(hourly, monthly *types.Float64) {
+	if hourly.IsUnknown() {
+		*hourly = types.Float64Null()
+	}
+	if monthly.IsUnknown() {
+		*monthly = types.Float64Null()
+	}
+}
@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PriceDataSource{}
+
+func NewPriceDataSource() datasource.DataSource {
+	return &PriceDataSource{}
+}
+
+// PriceDataSource defines the data source implementation.
+type PriceDataSource struct {
+	providerData *ProviderData
+}
+
+// PriceLineItemModel describes the price of a memory or network option.
+type PriceLineItemModel struct {
+	Amount       types.Float64 `tfsdk:"amount"`
+	Unit         types.String  `tfsdk:"unit"`
+	HourlyPrice  types.Float64 `tfsdk:"hourly_price"`
+	MonthlyPrice types.Float64 `tfsdk:"monthly_price"`
+}
+
+// DrivePriceModel describes the price of a drive.
+type DrivePriceModel struct {
+	Slot         types.String  `tfsdk:"slot"`
+	Size         types.Float64 `tfsdk:"size"`
+	Unit         types.String  `tfsdk:"unit"`
+	HourlyPrice  types.Float64 `tfsdk:"hourly_price"`
+	MonthlyPrice types.Float64 `tfsdk:"monthly_price"`
+}
+
+// PriceDataSourceModel describes the data source data model.
+type PriceDataSourceModel struct {
+	ServiceType      types.String        `tfsdk:"service_type"`
+	RegionID         types.String        `tfsdk:"region_id"`
+	TierID           types.String        `tfsdk:"tier_id"`
+	MemoryGB         types.Float64       `tfsdk:"memory_gb"`
+	NetworkGbps      types.Float64       `tfsdk:"network_gbps"`
+	Disks            types.Map           `tfsdk:"disks"`
+	Reserved         types.Bool          `tfsdk:"reserved"`
+	HourlyPrice      types.Float64       `tfsdk:"hourly_price"`
+	MonthlyPrice     types.Float64       `tfsdk:"monthly_price"`
+	TierHourlyPrice  types.Float64       `tfsdk:"tier_hourly_price"`
+	TierMonthlyPrice types.Float64       `tfsdk:"tier_monthly_price"`
+	Drives           []DrivePriceModel   `tfsdk:"drives"`
+	Memory           *PriceLineItemModel `tfsdk:"memory"`
+	Network          *PriceLineItemModel `tfsdk:"network"`
+}
+
+func (d *PriceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price"
+}
+
+// priceLineItemAttributes returns the attributes of a memory or network line
+// item.
+func priceLineItemAttributes(what string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"amount": schema.Float64Attribute{
+			MarkdownDescription: fmt.Sprintf("The amount of %s priced.", what),
+			Computed:            true,
+		},
+		"unit": schema.StringAttribute{
+			MarkdownDescription: "The unit of the amount.",
+			Computed:            true,
+		},
+		"hourly_price": schema.Float64Attribute{
+			MarkdownDescription: fmt.Sprintf("The hourly price for the %s option.", what),
+			Computed:            true,
+		},
+		"monthly_price": schema.Float64Attribute{
+			MarkdownDescription: fmt.Sprintf("The monthly price for the %s option.", what),
+			Computed:            true,
+		},
+	}
+}
+
+func (d *PriceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Price data source allows you to calculate the price of a service configuration, broken down into tier, drive, memory and network line items.",
+
+		Attributes: map[string]schema.Attribute{
+			"service_type": schema.StringAttribute{
+				MarkdownDescription: "The type of service to price. Valid values are: Metal, Instance. Defaults to Metal.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(serviceTypeMetal, serviceTypeInstance),
+				},
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the region to price the service in.",
+				Required:            true,
+			},
+			"tier_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the tier to price.",
+				Required:            true,
+			},
+			"memory_gb": schema.Float64Attribute{
+				MarkdownDescription: "The amount of memory in GB. Defaults to the tier's default memory option.",
+				Optional:            true,
+			},
+			"network_gbps": schema.Float64Attribute{
+				MarkdownDescription: "The network speed in Gbps. Defaults to the tier's default network option.",
+				Optional:            true,
+			},
+			"disks": schema.MapAttribute{
+				MarkdownDescription: "Dictionary of drive slots and drive names, in the format of the metal `disks` attribute. Defaults to the tier's default drives.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"reserved": schema.BoolAttribute{
+				MarkdownDescription: "Whether to price the service with reserve pricing.",
+				Optional:            true,
+			},
+			"hourly_price": schema.Float64Attribute{
+				MarkdownDescription: "The total hourly price for the service.",
+				Computed:            true,
+			},
+			"monthly_price": schema.Float64Attribute{
+				MarkdownDescription: "The total monthly price for the service.",
+				Computed:            true,
+			},
+			"tier_hourly_price": schema.Float64Attribute{
+				MarkdownDescription: "The base hourly price for the tier.",
+				Computed:            true,
+			},
+			"tier_monthly_price": schema.Float64Attribute{
+				MarkdownDescription: "The base monthly price for the tier.",
+				Computed:            true,
+			},
+			"drives": schema.ListNestedAttribute{
+				MarkdownDescription: "The price of each drive.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slot": schema.StringAttribute{
+							MarkdownDescription: "The drive slot.",
+							Computed:            true,
+						},
+						"size": schema.Float64Attribute{
+							MarkdownDescription: "The size of the drive.",
+							Computed:            true,
+						},
+						"unit": schema.StringAttribute{
+							MarkdownDescription: "The unit of the size.",
+							Computed:            true,
+						},
+						"hourly_price": schema.Float64Attribute{
+							MarkdownDescription: "The hourly price for the drive.",
+							Computed:            true,
+						},
+						"monthly_price": schema.Float64Attribute{
+							MarkdownDescription: "The monthly price for the drive.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"memory": schema.SingleNestedAttribute{
+				MarkdownDescription: "The price of the memory option.",
+				Computed:            true,
+				Attributes:          priceLineItemAttributes("memory"),
+			},
+			"network": schema.SingleNestedAttribute{
+				MarkdownDescription: "The price of the network option.",
+				Computed:            true,
+				Attributes:          priceLineItemAttributes("network"),
+			},
+		},
+	}
+}
+
+func (d *PriceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = client
+}
+
+func (d *PriceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PriceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceType := serviceTypeMetal
+	if !data.ServiceType.IsNull() {
+		serviceType = data.ServiceType.ValueString()
+	}
+
+	body := client.CalculatePriceRequest{
+		ServiceType: PtrTo(serviceType),
+		RegionId:    data.RegionID.ValueStringPointer(),
+		TierId:      data.TierID.ValueStringPointer(),
+		MemoryGb:    data.MemoryGB.ValueFloat64Pointer(),
+		NetworkGbps: data.NetworkGbps.ValueFloat64Pointer(),
+		Reserved:    data.Reserved.ValueBoolPointer(),
+	}
+	resp.Diagnostics.Append(data.Disks.ElementsAs(ctx, &body.Disks, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	price, err := calculatePrice(ctx, d.providerData.client, body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to calculate price, got error: %s", err))
+		return
+	}
+
+	data.HourlyPrice = types.Float64PointerValue(price.HourlyPrice)
+	data.MonthlyPrice = types.Float64PointerValue(price.MonthlyPrice)
+	data.TierHourlyPrice = types.Float64PointerValue(price.TierHourlyPrice)
+	data.TierMonthlyPrice = types.Float64PointerValue(price.TierMonthlyPrice)
+
+	data.Drives = []DrivePriceModel{}
+	if price.Drives != nil {
+		for _, drive := range *price.Drives {
+			data.Drives = append(data.Drives, DrivePriceModel{
+				Slot:         types.StringPointerValue(drive.Slot),
+				Size:         types.Float64PointerValue(drive.Size),
+				Unit:         types.StringPointerValue(drive.Unit),
+				HourlyPrice:  types.Float64PointerValue(drive.HourlyPrice),
+				MonthlyPrice: types.Float64PointerValue(drive.MonthlyPrice),
+			})
+		}
+	}
+
+	data.Memory = nil
+	if m := price.Memory; m != nil {
+		data.Memory = &PriceLineItemModel{
+			Amount:       types.Float64PointerValue(m.Amount),
+			Unit:         types.StringPointerValue(m.Unit),
+			HourlyPrice:  types.Float64PointerValue(m.HourlyPrice),
+			MonthlyPrice: types.Float64PointerValue(m.MonthlyPrice),
+		}
+	}
+
+	data.Network = nil
+	if n := price.Network; n != nil {
+		data.Network = &PriceLineItemModel{
+			Amount:       types.Float64PointerValue(n.Amount),
+			Unit:         types.StringPointerValue(n.Unit),
+			HourlyPrice:  types.Float64PointerValue(n.HourlyPrice),
+			MonthlyPrice: types.Float64PointerValue(n.MonthlyPrice),
+		}
+	}
+
+	tflog.Trace(ctx, "read price data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPriceDataSource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPriceDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teraswitch_price.metal", "monthly_price"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_price.metal", "tier_monthly_price"),
+					resource.TestCheckResourceAttr("data.teraswitch_price.metal", "drives.#", "2"),
+					resource.TestCheckResourceAttrSet("data.teraswitch_price.instance", "monthly_price"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPriceDataSourceConfig() string {
+	return `
+provider "teraswitch" {}
+
+data "teraswitch_price" "metal" {
+  region_id = "SLC1"
+  tier_id   = "7950x"
+  memory_gb = 128
+  disks = {
+    "nvme0n1" : "1.92t",
+    "nvme1n1" : "1.92t",
+  }
+}
+
+data "teraswitch_price" "instance" {
+  service_type = "Instance"
+  region_id    = "PIT1"
+  tier_id      = "s1.1c1g"
+}
+`
+}
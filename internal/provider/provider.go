@@ -2,16 +2,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,17 +34,22 @@ type TeraswitchProvider struct {
 }
 
 type ProviderData struct {
-	httpClient *http.Client
-	projectID  int64
-	apiKey     string
-	apiURL     string
-	client     *client.ClientWithResponses
+	httpClient     *http.Client
+	requestEditors []client.RequestEditorFn
+	projectID      int64
+	apiURL         string
+	client         *client.ClientWithResponses
+	spendGuard     *spendGuard
 }
 
 // TeraswitchProviderModel describes the provider data model.
 type TeraswitchProviderModel struct {
-	APIKey    types.String `tfsdk:"api_key"`
-	ProjectID types.Int64  `tfsdk:"project_id"`
+	APIKey              types.String  `tfsdk:"api_key"`
+	ProjectID           types.Int64   `tfsdk:"project_id"`
+	MaxRetries          types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait        types.String  `tfsdk:"retry_max_wait"`
+	MaxMonthlySpend     types.Float64 `tfsdk:"max_monthly_spend"`
+	IncludeCurrentSpend types.Bool    `tfsdk:"include_current_spend"`
 }
 
 func (p *TeraswitchProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -58,6 +69,28 @@ func (p *TeraswitchProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				MarkdownDescription: "Project ID from beta.tsw.io. Used as the default if a project id isn't supplied on a resource.",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of times a failed API request is retried. Requests are retried on connection errors and on 429, 502, 503 and 504 responses, and only when they are safe to repeat. Set to `0` to disable retries. Defaults to `%d`.", defaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait between retries, such as `10s` or `1m`. Backoff grows exponentially with jitter up to this limit, and a `Retry-After` header from the API is honoured up to it. Defaults to `%s`.", defaultRetryMaxWait),
+				Optional:            true,
+			},
+			"max_monthly_spend": schema.Float64Attribute{
+				MarkdownDescription: "Guardrail on the monthly cost of a plan. The estimated monthly price of every server the plan would create or replace is added up, and planning fails if the total exceeds this amount. Can also be set with the `TERASWITCH_MAX_MONTHLY_SPEND` environment variable. Unset by default, which disables the check.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"include_current_spend": schema.BoolAttribute{
+				MarkdownDescription: "Count the project's usage so far this month towards `max_monthly_spend`. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -98,32 +131,73 @@ func (p *TeraswitchProvider) Configure(ctx context.Context, req provider.Configu
 		}
 	}
 
+	if data.MaxMonthlySpend.IsNull() {
+		if limitEnv, ok := os.LookupEnv("TERASWITCH_MAX_MONTHLY_SPEND"); ok {
+			limit, err := strconv.ParseFloat(limitEnv, 64)
+			if err != nil || limit < 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("max_monthly_spend"),
+					"max_monthly_spend invalid",
+					fmt.Sprintf("Expected TERASWITCH_MAX_MONTHLY_SPEND to be a non-negative number, got %q.", limitEnv),
+				)
+				return
+			}
+			data.MaxMonthlySpend = types.Float64Value(limit)
+		}
+	}
+
 	// Determine API URL (use dev URL if set, otherwise prod)
 	apiURL := "https://api.tsw.io"
 	if devURL, ok := os.LookupEnv("TERASWITCH_DEV_API_URL"); ok {
 		apiURL = devURL
 	}
 
-	httpClient := &http.Client{}
+	maxRetries := defaultMaxRetries
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
 
-	reqClient, err := client.NewClientWithResponses(apiURL,
-		client.WithHTTPClient(httpClient),
-		client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-			req.Header.Add("Authorization", "Bearer "+data.APIKey.ValueString())
-			return nil
-		}),
-	)
+	retryMaxWait := defaultRetryMaxWait
+	if !data.RetryMaxWait.IsNull() {
+		wait, err := time.ParseDuration(data.RetryMaxWait.ValueString())
+		if err != nil || wait < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"retry_max_wait invalid",
+				fmt.Sprintf("Expected retry_max_wait to be a non-negative duration such as \"30s\", got %q.", data.RetryMaxWait.ValueString()),
+			)
+			return
+		}
+		retryMaxWait = wait
+	}
+
+	httpClient := &http.Client{
+		Transport: newRetryTransport(http.DefaultTransport, maxRetries, retryMaxWait),
+	}
+
+	requestEditors := newRequestEditors(data.APIKey.ValueString())
+
+	clientOpts := []client.ClientOption{client.WithHTTPClient(httpClient)}
+	for _, edit := range requestEditors {
+		clientOpts = append(clientOpts, client.WithRequestEditorFn(edit))
+	}
+
+	reqClient, err := client.NewClientWithResponses(apiURL, clientOpts...)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create client", err.Error())
 		return
 	}
 
 	pd := &ProviderData{
-		client:     reqClient,
-		httpClient: httpClient,
-		projectID:  data.ProjectID.ValueInt64(),
-		apiKey:     data.APIKey.ValueString(),
-		apiURL:     apiURL,
+		client:         reqClient,
+		httpClient:     httpClient,
+		requestEditors: requestEditors,
+		projectID:      data.ProjectID.ValueInt64(),
+		apiURL:         apiURL,
+	}
+
+	if !data.MaxMonthlySpend.IsNull() {
+		pd.spendGuard = newSpendGuard(data.MaxMonthlySpend.ValueFloat64(), data.IncludeCurrentSpend.ValueBool(), reqClient, pd.projectID)
 	}
 
 	// Example client configuration for data sources and resources
@@ -135,8 +209,10 @@ func (p *TeraswitchProvider) Resources(ctx context.Context) []func() resource.Re
 	return []func() resource.Resource{
 		NewNetworkResource,
 		NewVolumeResource,
+		NewVolumeAttachmentResource,
 		NewMetalResource,
 		NewCloudComputeResource,
+		NewInstanceNetworkAttachmentResource,
 		NewSshKeyResource,
 	}
 }
@@ -144,8 +220,18 @@ func (p *TeraswitchProvider) Resources(ctx context.Context) []func() resource.Re
 func (p *TeraswitchProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewMetalDataSource,
+		NewMetalsDataSource,
+		NewMetalLogsDataSource,
+		NewCloudComputeDataSource,
+		NewCloudComputesDataSource,
 		NewSshKeysDataSource,
 		NewMetalTiersDataSource,
+		NewMetalTemplatesDataSource,
+		NewMetalAvailabilityDataSource,
+		NewPriceDataSource,
+		NewCloudTiersDataSource,
+		NewImageDataSource,
+		NewImagesDataSource,
 		NewRegionsDataSource,
 		NewTagsDataSource,
 	}
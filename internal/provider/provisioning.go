@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// privateKeyProvisioning is the private state key recording that a server was
+// created but never finished provisioning. Such servers are saved to state so
+// they aren't leaked, and Terraform marks them as tainted.
+const privateKeyProvisioning = "provisioning_incomplete"
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func markProvisioningIncomplete(ctx context.Context, private privateStateSetter) diag.Diagnostics {
+	return private.SetKey(ctx, privateKeyProvisioning, []byte(`true`))
+}
+
+func clearProvisioningIncomplete(ctx context.Context, private privateStateSetter) diag.Diagnostics {
+	// Setting a nil value removes the key
+	return private.SetKey(ctx, privateKeyProvisioning, nil)
+}
+
+func provisioningIncomplete(ctx context.Context, private privateStateGetter) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateKeyProvisioning)
+	return len(value) > 0, diags
+}
+
+// planResumeProvisioning plans ip_addresses as unknown for a tracked server
+// whose provisioning didn't finish, and reports whether it did. The server's
+// configuration usually matches state, so this is what makes Terraform plan
+// the Update that resumes waiting.
+func planResumeProvisioning(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) (bool, diag.Diagnostics) {
+	if req.State.Raw.IsNull() {
+		return false, nil
+	}
+
+	incomplete, diags := provisioningIncomplete(ctx, req.Private)
+	if diags.HasError() || !incomplete {
+		return false, diags
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("ip_addresses"), types.ListUnknown(types.StringType))...)
+	return true, diags
+}
@@ -129,9 +129,7 @@ func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to read regions, got status %d: %s", res.StatusCode(), string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("read regions", res.StatusCode(), res.Body, req.Config.Raw)...)
 		return
 	}
 
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// doRequest sends a request to an API endpoint that the generated client
+// doesn't cover. It goes through the same HTTP client and request editors as
+// the generated client, so it is authenticated and retried the same way. The
+// response body is read and returned with the status code.
+func (pd *ProviderData) doRequest(ctx context.Context, method, path string, query url.Values) (int, []byte, error) {
+	u := strings.TrimSuffix(pd.apiURL, "/") + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("create %s %s request: %w", method, path, err)
+	}
+
+	for _, edit := range pd.requestEditors {
+		if err := edit(ctx, req); err != nil {
+			return 0, nil, fmt.Errorf("edit %s %s request: %w", method, path, err)
+		}
+	}
+
+	res, err := pd.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("send %s %s request: %w", method, path, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("read %s %s response: %w", method, path, err)
+	}
+
+	tflog.Debug(ctx, "sent API request", map[string]interface{}{
+		"method": method,
+		"path":   path,
+		"status": res.StatusCode,
+	})
+
+	return res.StatusCode, body, nil
+}
+
+// newRequestEditors returns the editors applied to every API request, which
+// authenticate it with the API key.
+func newRequestEditors(apiKey string) []client.RequestEditorFn {
+	return []client.RequestEditorFn{
+		func(ctx context.Context, req *http.Request) error {
+			req.Header.Add("Authorization", "Bearer "+apiKey)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoRequest(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, "/v1/Metal/42", r.URL.Path)
+		require.Equal(t, "7", r.URL.Query().Get("projectId"))
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		// The shared path goes through the retrying client
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found"}`))
+	}))
+	defer srv.Close()
+
+	pd := &ProviderData{
+		httpClient:     &http.Client{Transport: newRetryTransport(http.DefaultTransport, 2, time.Second)},
+		requestEditors: newRequestEditors("secret"),
+		apiURL:         srv.URL + "/",
+	}
+
+	status, body, err := pd.doRequest(context.Background(), http.MethodDelete, "/v1/Metal/42", url.Values{"projectId": []string{"7"}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, status)
+	require.JSONEq(t, `{"message":"not found"}`, string(body))
+	require.Equal(t, int32(2), calls.Load())
+}
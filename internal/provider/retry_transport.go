@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 4
+	defaultRetryMaxWait = 30 * time.Second
+
+	// retryBaseWait is the backoff before the first retry. It doubles with
+	// every attempt up to the configured maximum wait.
+	retryBaseWait = 1 * time.Second
+)
+
+// retrySafePOSTs matches POST endpoints that can be repeated without side
+// effects, such as power commands and price calculations.
+var retrySafePOSTs = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)^/v2/(Metal|Instance)/\d+/PowerCommand$`),
+	regexp.MustCompile(`(?i)^/v2/Metal/\d+/rename$`),
+	regexp.MustCompile(`(?i)^/v2/Price/Calculate$`),
+	regexp.MustCompile(`(?i)^/v2/Tags/service$`),
+}
+
+// retryTransport retries requests that fail with a connection error or a
+// transient status code, using exponential backoff with jitter and honouring
+// Retry-After. Only idempotent methods and POSTs in retrySafePOSTs are retried.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxWait time.Duration) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		maxWait:    maxWait,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !retryableRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		res, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !retryableResponse(res, err) || ctx.Err() != nil {
+			return res, err
+		}
+
+		wait := t.backoff(attempt, res)
+
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.Redacted(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = res.StatusCode
+
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+		tflog.Debug(ctx, "retrying teraswitch api request", fields)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// backoff returns how long to wait before retrying. A Retry-After header takes
+// precedence over exponential backoff, and neither exceeds the maximum wait.
+func (t *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, t.maxWait)
+		}
+	}
+
+	wait := t.maxWait
+	if attempt < 30 {
+		wait = min(retryBaseWait<<attempt, t.maxWait)
+	}
+
+	// Full jitter, keeping at least half of the backoff
+	return wait/2 + rand.N(wait/2+1)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+
+	return 0, false
+}
+
+func retryableRequest(req *http.Request) bool {
+	// Requests with a body that can't be replayed can't be retried
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		for _, re := range retrySafePOSTs {
+			if re.MatchString(req.URL.Path) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func retryableResponse(res *http.Response, err error) bool {
+	if err != nil {
+		// Connection resets, refused connections and the like
+		return true
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
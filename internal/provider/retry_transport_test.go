@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		failures  int32
+		status    int
+		wantCalls int32
+		wantCode  int
+	}{
+		{"get retried until success", http.MethodGet, "/v2/Metal/1", 2, http.StatusServiceUnavailable, 3, http.StatusOK},
+		{"get gives up after max retries", http.MethodGet, "/v2/Metal/1", 10, http.StatusBadGateway, 3, http.StatusBadGateway},
+		{"client errors aren't retried", http.MethodGet, "/v2/Metal/1", 1, http.StatusBadRequest, 1, http.StatusBadRequest},
+		{"safe post retried", http.MethodPost, "/v2/Metal/1/PowerCommand", 1, http.StatusTooManyRequests, 2, http.StatusOK},
+		{"unsafe post not retried", http.MethodPost, "/v2/Metal", 1, http.StatusServiceUnavailable, 1, http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) <= tt.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			c := &http.Client{Transport: newRetryTransport(nil, 2, time.Millisecond)}
+
+			req, err := http.NewRequest(tt.method, srv.URL+tt.path, strings.NewReader(`{}`))
+			require.NoError(t, err)
+
+			res, err := c.Do(req)
+			require.NoError(t, err)
+			_ = res.Body.Close()
+
+			require.Equal(t, tt.wantCode, res.StatusCode)
+			require.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("5")
+	require.True(t, ok)
+	require.Equal(t, 5*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	require.True(t, ok)
+	require.InDelta(t, time.Minute, wait, float64(2*time.Second))
+
+	_, ok = parseRetryAfter("soon")
+	require.False(t, ok)
+}
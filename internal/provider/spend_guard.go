@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// spendGuard enforces the provider's max_monthly_spend. Terraform plans each
+// resource separately, so every create or replace of a priced resource adds
+// its estimate to a running total shared through ProviderData; the plan fails
+// once the total passes the limit.
+type spendGuard struct {
+	limit          float64
+	includeCurrent bool
+	client         *client.ClientWithResponses
+	projectID      int64
+
+	mu      sync.Mutex
+	planned float64
+	current *float64
+}
+
+func newSpendGuard(limit float64, includeCurrent bool, c *client.ClientWithResponses, projectID int64) *spendGuard {
+	return &spendGuard{
+		limit:          limit,
+		includeCurrent: includeCurrent,
+		client:         c,
+		projectID:      projectID,
+	}
+}
+
+// add records the monthly price of a planned resource and returns an error
+// diagnostic if the total exceeds the limit. A nil guard allows everything.
+func (g *spendGuard) add(ctx context.Context, description string, monthly float64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if g == nil {
+		return diags
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.planned += monthly
+
+	current := 0.0
+	if g.includeCurrent {
+		if g.current == nil {
+			spend, err := g.currentSpend(ctx)
+			if err != nil {
+				tflog.Warn(ctx, "ignoring current spend for max_monthly_spend, unable to read usage", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+			g.current = &spend
+		}
+		current = *g.current
+	}
+
+	tflog.Debug(ctx, "checked planned spend", map[string]interface{}{
+		"resource":      description,
+		"monthly_price": monthly,
+		"planned_total": g.planned,
+		"current_spend": current,
+		"limit":         g.limit,
+	})
+
+	if current+g.planned > g.limit {
+		detail := fmt.Sprintf("Planning %s (%.2f/month) brings the resources created or replaced by this plan to %.2f/month", description, monthly, g.planned)
+		if g.includeCurrent {
+			detail += fmt.Sprintf(", plus %.2f of usage this month", current)
+		}
+		detail += fmt.Sprintf(", which exceeds the provider max_monthly_spend of %.2f. "+
+			"Check the plan for unintended resources, or raise max_monthly_spend.", g.limit)
+		diags.AddError("Monthly Spend Limit Exceeded", detail)
+	}
+
+	return diags
+}
+
+// currentSpend returns the project's usage total for the current month.
+func (g *spendGuard) currentSpend(ctx context.Context) (float64, error) {
+	now := time.Now().UTC()
+	params := &client.GetV2UsageParams{
+		Year:  PtrTo(int32(now.Year())),
+		Month: PtrTo(int32(now.Month())),
+	}
+	if g.projectID != 0 {
+		params.ProjectId = PtrTo(g.projectID)
+	}
+
+	res, err := g.client.GetV2UsageWithResponse(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return 0, fmt.Errorf("get usage: %w", newAPIError(res.StatusCode(), res.Body))
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		return 0, nil
+	}
+
+	return derefOr(res.JSON200.Result.Total, 0), nil
+}
+
+// checkPlannedSpend adds a planned resource's estimated_monthly_price to the
+// spend guard when the plan creates it. It must run after the estimate has
+// been set on the plan.
+func checkPlannedSpend(ctx context.Context, g *spendGuard, typeName string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Terraform plans a replacement twice: once against the prior state, and
+	// again without it to plan the new resource. Only the latter is counted.
+	if g == nil || !req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var displayName types.String
+	var monthly types.Float64
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("display_name"), &displayName)...)
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("estimated_monthly_price"), &monthly)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	description := typeName
+	if !displayName.IsNull() && !displayName.IsUnknown() {
+		description = fmt.Sprintf("%s %q", typeName, displayName.ValueString())
+	}
+
+	if monthly.IsNull() || monthly.IsUnknown() {
+		diags.AddWarning(
+			"Monthly Spend Not Checked",
+			fmt.Sprintf("The price of %s couldn't be estimated while planning, so it isn't counted towards max_monthly_spend.", description),
+		)
+		return diags
+	}
+
+	diags.Append(g.add(ctx, description, monthly.ValueFloat64())...)
+
+	return diags
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSpendGuard(t *testing.T) {
+	ctx := context.Background()
+
+	var unlimited *spendGuard
+	if diags := unlimited.add(ctx, "server", 1e9); diags.HasError() {
+		t.Fatalf("nil guard should allow everything, got %v", diags)
+	}
+
+	g := newSpendGuard(1000, false, nil, 0)
+	for i := 0; i < 3; i++ {
+		if diags := g.add(ctx, "server", 300); diags.HasError() {
+			t.Fatalf("server %d should fit within the limit, got %v", i, diags)
+		}
+	}
+	diags := g.add(ctx, "server", 300)
+	if !diags.HasError() {
+		t.Fatal("expected the fourth server to exceed the limit")
+	}
+	if got := diags[0].Summary(); got != "Monthly Spend Limit Exceeded" {
+		t.Fatalf("unexpected diagnostic %q", got)
+	}
+
+	withCurrent := newSpendGuard(1000, true, nil, 0)
+	withCurrent.current = PtrTo(800.0)
+	if diags := withCurrent.add(ctx, "server", 300); !diags.HasError() {
+		t.Fatal("expected current spend to count towards the limit")
+	}
+}
+
+func TestCheckPlannedSpend_replacement(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"display_name":            schema.StringAttribute{Required: true},
+			"estimated_monthly_price": schema.Float64Attribute{Computed: true},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+	value := func(price float64) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"display_name":            tftypes.NewValue(tftypes.String, "db1"),
+			"estimated_monthly_price": tftypes.NewValue(tftypes.Number, price),
+		})
+	}
+
+	g := newSpendGuard(1000, false, nil, 0)
+
+	// Terraform first plans the replacement against the prior state, then
+	// plans the new server without it.
+	for _, prior := range []tftypes.Value{value(400), tftypes.NewValue(objectType, nil)} {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: s, Raw: prior},
+			Plan:  tfsdk.Plan{Schema: s, Raw: value(600)},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+		if diags := checkPlannedSpend(ctx, g, "teraswitch_metal", req, resp); diags.HasError() {
+			t.Fatalf("replacement should fit within the limit, got %v", diags)
+		}
+	}
+
+	if g.planned != 600 {
+		t.Fatalf("expected the replacement to be counted once, got %v planned", g.planned)
+	}
+}
@@ -128,9 +128,7 @@ func (r *SshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create SSH key, got status %d: %s", res.StatusCode(), string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("create SSH key", res.StatusCode(), res.Body, req.Plan.Raw)...)
 		return
 	}
 
@@ -183,9 +181,7 @@ func (r *SshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to read SSH key, got status %d: %s", res.StatusCode(), string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("read SSH key", res.StatusCode(), res.Body, req.State.Raw)...)
 		return
 	}
 
@@ -247,9 +243,7 @@ func (r *SshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to delete SSH key, got status %d: %s", res.StatusCode(), string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("delete SSH key", res.StatusCode(), res.Body, req.State.Raw)...)
 		return
 	}
 
@@ -111,9 +111,7 @@ func (d *SshKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to read SSH keys, got error: %s", string(res.Body)),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("read SSH keys", res.StatusCode(), res.Body, req.Config.Raw)...)
 		return
 	}
 
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceTimeouts are the default deadlines of a resource's operations.
+type resourceTimeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// timeoutsBlock returns the `timeouts` block shared by resources that wait on
+// the API. The configured deadlines apply to every API call and waiter of the
+// operation.
+func timeoutsBlock(ctx context.Context, defaults resourceTimeouts) schema.Block {
+	description := func(op string, d time.Duration) string {
+		// Render durations the way they're configured, e.g. 30m rather than 30m0s
+		ds := strings.Replace(strings.Replace(d.String(), "m0s", "m", 1), "h0m", "h", 1)
+		return fmt.Sprintf("How long to wait for the resource to be %s, such as `45m` or `2h`. Defaults to `%s`.", op, ds)
+	}
+
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: description("created", defaults.Create),
+		UpdateDescription: description("updated", defaults.Update),
+		DeleteDescription: description("deleted", defaults.Delete),
+	})
+}
+
+// nullTimeouts returns an unset `timeouts` value for state that isn't built
+// from a plan, such as on import.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
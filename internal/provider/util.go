@@ -1,5 +1,45 @@
 package provider
 
+import (
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
 func PtrTo[T any](v T) *T {
 	return &v
 }
+
+// derefOr returns the value ptr points to, or def if ptr is nil.
+func derefOr[T any](ptr *T, def T) T {
+	if ptr == nil {
+		return def
+	}
+	return *ptr
+}
+
+// projectIDFilter returns the project filter of a list data source: the
+// configured project_id, falling back to the provider's project. A nil result
+// means no filter.
+func projectIDFilter(configured types.Int64, providerProjectID int64) (*int32, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projectID := providerProjectID
+	if !configured.IsNull() {
+		projectID = configured.ValueInt64()
+	}
+	if projectID == 0 {
+		return nil, diags
+	}
+
+	if projectID > math.MaxInt32 || projectID < math.MinInt32 {
+		diags.AddAttributeError(path.Root("project_id"), "Invalid Project ID",
+			fmt.Sprintf("Project ID %d is out of range.", projectID))
+		return nil, diags
+	}
+
+	return PtrTo(int32(projectID)), diags
+}
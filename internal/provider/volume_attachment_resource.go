@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TeraSwitch/terraform-provider/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VolumeAttachmentResource{}
+var _ resource.ResourceWithImportState = &VolumeAttachmentResource{}
+
+func NewVolumeAttachmentResource() resource.Resource {
+	return &VolumeAttachmentResource{}
+}
+
+// volumeAttachmentTimeouts are the default deadlines of the resource's operations.
+var volumeAttachmentTimeouts = resourceTimeouts{
+	Create: 10 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
+// VolumeAttachmentResource defines the resource implementation.
+type VolumeAttachmentResource struct {
+	providerData *ProviderData
+}
+
+// VolumeAttachmentResourceModel describes the resource data model.
+type VolumeAttachmentResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	VolumeID   types.String   `tfsdk:"volume_id"`
+	InstanceID types.Int64    `tfsdk:"instance_id"`
+	MountPoint types.String   `tfsdk:"mount_point"`
+	RegionID   types.String   `tfsdk:"region_id"`
+	Device     types.String   `tfsdk:"device"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *VolumeAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_attachment"
+}
+
+func (r *VolumeAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches a volume to a cloud compute instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the attachment in the form `volume_id/instance_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the volume to attach.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the cloud compute instance to attach the volume to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"mount_point": schema.StringAttribute{
+				MarkdownDescription: "The path the volume is mounted at on the instance, like `/mnt/data`. Changing it detaches and reattaches the volume. An imported attachment records the configured path on its next apply, as the API doesn't return it.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// Imported attachments have no mount point in state yet.
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the mount point of a tracked attachment requires replacement.",
+						"Changing the mount point of a tracked attachment requires replacement.",
+					),
+				},
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the region that the volume and instance are in.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "The path of the device on the instance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, volumeAttachmentTimeouts),
+		},
+	}
+}
+
+func (r *VolumeAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = client
+}
+
+func (r *VolumeAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VolumeAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, volumeAttachmentTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	vol, err := r.findVolume(ctx, data.VolumeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find volume", err.Error())
+		return
+	}
+	if vol.Region == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Volume %q has no region", data.VolumeID.ValueString()))
+		return
+	}
+
+	res, err := r.providerData.client.PutV2VolumeAttachWithResponse(ctx, &client.PutV2VolumeAttachParams{
+		ProjectId: &r.providerData.projectID,
+	}, client.AttachVolumeRequest{
+		InstanceId: data.InstanceID.ValueInt64Pointer(),
+		MountPoint: data.MountPoint.ValueString(),
+		RegionId:   *vol.Region,
+		VolumeId:   data.VolumeID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach volume, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("attach volume", res.StatusCode(), res.Body, req.Plan.Raw)...)
+		return
+	}
+
+	data.ID = types.StringValue(volumeAttachmentID(data.VolumeID.ValueString(), data.InstanceID.ValueInt64()))
+	data.RegionID = types.StringValue(*vol.Region)
+	data.Device = types.StringNull()
+
+	// Track the attachment before waiting so it isn't leaked if the wait fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachment, err := r.waitAttached(ctx, data.VolumeID.ValueString(), data.InstanceID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to wait for volume attachment, got error: %s\n\n"+
+				"The attachment has been saved to state as tainted, so the next apply will detach and reattach the volume.", err),
+		)
+		return
+	}
+
+	data.Device = types.StringPointerValue(attachment.Device)
+
+	tflog.Trace(ctx, "attached volume")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VolumeAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vol, attachment, err := r.findAttachment(ctx, data.VolumeID.ValueString(), data.InstanceID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume attachment, got error: %s", err))
+		return
+	}
+
+	if attachment == nil {
+		// Volume is no longer attached to the instance, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(volumeAttachmentID(data.VolumeID.ValueString(), data.InstanceID.ValueInt64()))
+	data.RegionID = types.StringPointerValue(vol.Region)
+	data.Device = types.StringPointerValue(attachment.Device)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VolumeAttachmentResourceModel
+
+	// Only reachable when adopting the configured mount point after an import.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VolumeAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, volumeAttachmentTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	res, err := r.providerData.client.PutV2VolumeDetachWithResponse(ctx, &client.PutV2VolumeDetachParams{
+		ProjectId: &r.providerData.projectID,
+	}, client.DetachVolumeRequest{
+		RegionId: data.RegionID.ValueString(),
+		VolumeId: data.VolumeID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach volume, got error: %s", err))
+		return
+	}
+
+	if res.StatusCode() == http.StatusNotFound {
+		// Volume already gone, nothing to do
+		return
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(apiErrorDiagnostics("detach volume", res.StatusCode(), res.Body, req.State.Raw)...)
+		return
+	}
+
+	if err := r.waitDetached(ctx, data.VolumeID.ValueString(), data.InstanceID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to wait for volume detachment, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "detached volume")
+}
+
+func (r *VolumeAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeID, instanceIDStr, ok := strings.Cut(req.ID, "/")
+	instanceID, err := strconv.ParseInt(instanceIDStr, 10, 64)
+	if !ok || volumeID == "" || err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Invalid resource ID format: %s. Expected volume_id/instance_id.", req.ID),
+		)
+		return
+	}
+
+	state := VolumeAttachmentResourceModel{
+		ID:         types.StringValue(volumeAttachmentID(volumeID, instanceID)),
+		VolumeID:   types.StringValue(volumeID),
+		InstanceID: types.Int64Value(instanceID),
+		MountPoint: types.StringNull(),
+		RegionID:   types.StringNull(),
+		Device:     types.StringNull(),
+		Timeouts:   nullTimeouts(),
+	}
+
+	// Set the state directly - this will trigger a Read to populate the rest
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func volumeAttachmentID(volumeID string, instanceID int64) string {
+	return fmt.Sprintf("%s/%d", volumeID, instanceID)
+}
+
+// findVolume looks up a volume in the project by its ID.
+func (r *VolumeAttachmentResource) findVolume(ctx context.Context, volumeID string) (*client.ListVolumesResponseRecord, error) {
+	res, err := r.providerData.client.GetV2VolumeWithResponse(ctx, &client.GetV2VolumeParams{
+		ProjectId: &r.providerData.projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting v2 volumes: %w", err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("get v2 volumes: %w", newAPIError(res.StatusCode(), res.Body))
+	}
+
+	if res.JSON200 != nil && res.JSON200.Result != nil {
+		for _, vol := range *res.JSON200.Result {
+			if vol.VolumeId != nil && *vol.VolumeId == volumeID {
+				return &vol, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find volume %q", volumeID)
+}
+
+// findAttachment returns the volume and its attachment to the instance. A nil
+// attachment means the volume isn't attached to the instance.
+func (r *VolumeAttachmentResource) findAttachment(ctx context.Context, volumeID string, instanceID int64) (*client.ListVolumesResponseRecord, *client.VolumeAttachment, error) {
+	res, err := r.providerData.client.GetV2VolumeListAttachedWithResponse(ctx, &client.GetV2VolumeListAttachedParams{
+		ProjectId:  &r.providerData.projectID,
+		InstanceId: &instanceID,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("send list attached volumes request: %w", err)
+	}
+
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, nil, nil
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, nil, fmt.Errorf("list attached volumes: %w", newAPIError(res.StatusCode(), res.Body))
+	}
+
+	if res.JSON200 == nil || res.JSON200.Result == nil {
+		return nil, nil, nil
+	}
+
+	for _, vol := range *res.JSON200.Result {
+		if vol.VolumeId == nil || *vol.VolumeId != volumeID || vol.Attachments == nil {
+			continue
+		}
+
+		for _, attachment := range *vol.Attachments {
+			if attachment.ServerId != nil && *attachment.ServerId == instanceID {
+				return &vol, &attachment, nil
+			}
+		}
+	}
+
+	return nil, nil, nil
+}
+
+func (r *VolumeAttachmentResource) waitAttached(ctx context.Context, volumeID string, instanceID int64) (*client.VolumeAttachment, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout waiting for volume to attach: %w", ctx.Err())
+		case <-time.After(3 * time.Second):
+		}
+
+		_, attachment, err := r.findAttachment(ctx, volumeID, instanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if attachment == nil {
+			tflog.Debug(ctx, "waiting for volume attachment", map[string]interface{}{
+				"volume_id":   volumeID,
+				"instance_id": instanceID,
+			})
+			continue
+		}
+
+		return attachment, nil
+	}
+}
+
+func (r *VolumeAttachmentResource) waitDetached(ctx context.Context, volumeID string, instanceID int64) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for volume to detach: %w", ctx.Err())
+		case <-time.After(3 * time.Second):
+		}
+
+		_, attachment, err := r.findAttachment(ctx, volumeID, instanceID)
+		if err != nil {
+			return err
+		}
+
+		if attachment != nil {
+			tflog.Debug(ctx, "waiting for volume detachment", map[string]interface{}{
+				"volume_id":   volumeID,
+				"instance_id": instanceID,
+			})
+			continue
+		}
+
+		return nil
+	}
+}
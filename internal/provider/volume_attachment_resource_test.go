@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVolumeAttachmentResource(t *testing.T) {
+	if os.Getenv("TERASWITCH_API_KEY") == "" {
+		t.Skip("Skipping, api key not provided")
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVolumeAttachmentResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("teraswitch_volume_attachment.test", "volume_id", "teraswitch_volume.test", "id"),
+					resource.TestCheckResourceAttrPair("teraswitch_volume_attachment.test", "instance_id", "teraswitch_cloud_compute.test", "id"),
+					resource.TestCheckResourceAttr("teraswitch_volume_attachment.test", "mount_point", "/mnt/data"),
+					resource.TestCheckResourceAttr("teraswitch_volume_attachment.test", "region_id", "PIT1"),
+					resource.TestCheckResourceAttrSet("teraswitch_volume_attachment.test", "device"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "teraswitch_volume_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API doesn't report the mount point
+				ImportStateVerifyIgnore: []string{"mount_point"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccVolumeAttachmentResourceConfig() string {
+	return `
+provider "teraswitch" {}
+
+resource "teraswitch_volume" "test" {
+  region_id    = "PIT1"
+  display_name = "yeehaw"
+  size         = 20
+  volume_type  = "nvme"
+}
+
+resource "teraswitch_cloud_compute" "test" {
+  region_id    = "PIT1"
+  tier_id      = "s1.1c1g"
+  image_id     = "ubuntu-noble"
+  display_name = "yeehaw"
+  boot_size    = 64
+  ssh_key_ids  = [588]
+}
+
+resource "teraswitch_volume_attachment" "test" {
+  volume_id   = teraswitch_volume.test.id
+  instance_id = teraswitch_cloud_compute.test.id
+  mount_point = "/mnt/data"
+}
+`
+}
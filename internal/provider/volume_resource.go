@@ -6,15 +6,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/TeraSwitch/terraform-provider/client"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -22,11 +28,23 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &VolumeResource{}
 var _ resource.ResourceWithImportState = &VolumeResource{}
+var _ resource.ResourceWithModifyPlan = &VolumeResource{}
 
 func NewVolumeResource() resource.Resource {
 	return &VolumeResource{}
 }
 
+// volumeTimeouts are the default deadlines of the resource's operations.
+var volumeTimeouts = resourceTimeouts{
+	Create: 10 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
+// volumeReplaceAttributes are the attributes whose change replaces the
+// volume.
+var volumeReplaceAttributes = []string{"region_id", "display_name", "volume_type", "description", "image_name"}
+
 // VolumeResource defines the resource implementation.
 type VolumeResource struct {
 	providerData *ProviderData
@@ -34,14 +52,15 @@ type VolumeResource struct {
 
 // VolumeResourceModel describes the resource data model.
 type VolumeResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	RegionID    types.String `tfsdk:"region_id"`
-	DisplayName types.String `tfsdk:"display_name"`
-	VolumeType  types.String `tfsdk:"volume_type"`
-	Size        types.Int64  `tfsdk:"size"`
-	Description types.String `tfsdk:"description"`
-	ImageName   types.String `tfsdk:"image_name"`
-	Status      types.String `tfsdk:"status"`
+	ID          types.String   `tfsdk:"id"`
+	RegionID    types.String   `tfsdk:"region_id"`
+	DisplayName types.String   `tfsdk:"display_name"`
+	VolumeType  types.String   `tfsdk:"volume_type"`
+	Size        types.Int64    `tfsdk:"size"`
+	Description types.String   `tfsdk:"description"`
+	ImageName   types.String   `tfsdk:"image_name"`
+	Status      types.String   `tfsdk:"status"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *VolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,8 +102,11 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"size": schema.Int64Attribute{
-				MarkdownDescription: "The size of the volume in gibibytes (GiB).",
+				MarkdownDescription: "The size of the volume in gibibytes (GiB). The size can be increased in place but can't be decreased.",
 				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, math.MaxInt32),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the volume.",
@@ -105,6 +127,10 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, volumeTimeouts),
+		},
 	}
 }
 
@@ -177,6 +203,15 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, volumeTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res, err := r.providerData.client.PostV2Volume(ctx, &client.PostV2VolumeParams{
 		ProjectId: &r.providerData.projectID,
 	}, client.CreateVolumeRequest{
@@ -202,13 +237,7 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Check status code before trying to decode
 	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		errMsg := string(body)
-		if errMsg == "" {
-			errMsg = fmt.Sprintf("API returned status %d with empty response", res.StatusCode)
-		}
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create volume: %s", errMsg),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("create volume", res.StatusCode, body, req.Plan.Raw)...)
 		return
 	}
 
@@ -270,26 +299,98 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (r *VolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan VolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Size.IsUnknown() || plan.Size.IsNull() || state.Size.IsNull() {
+		return
+	}
+
+	// A replaced volume can have any size. The attribute plan modifiers'
+	// RequiresReplace isn't passed to ModifyPlan, so look for the changes
+	// that trigger it.
+	if planChanged(req, volumeReplaceAttributes...) {
+		return
+	}
+
+	if plan.Size.ValueInt64() < state.Size.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size"),
+			"Volume Size Cannot Be Decreased",
+			fmt.Sprintf("Volume %s is %d GiB and can't be shrunk to %d GiB. Volumes can only be extended; "+
+				"to use a smaller volume, create a new one and migrate the data.",
+				state.ID.ValueString(), state.Size.ValueInt64(), plan.Size.ValueInt64()),
+		)
+	}
+}
+
 func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data VolumeResourceModel
+	var data, state VolumeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
+	updateTimeout, diags := data.Timeouts.Update(ctx, volumeTimeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-type ApiResponse struct {
-	// Message Provides additional detail about the response if one is required
-	Message string `json:"message"`
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if data.Size.ValueInt64() > state.Size.ValueInt64() {
+		res, err := r.providerData.client.PutV2VolumeExtendWithResponse(ctx, &client.PutV2VolumeExtendParams{
+			ProjectId: &r.providerData.projectID,
+		}, client.ExtendVolumeRequest{
+			NewSize:  int32(data.Size.ValueInt64()),
+			RegionId: data.RegionID.ValueString(),
+			VolumeId: data.ID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to extend volume, got error: %s", err))
+			return
+		}
 
-	// Success True if the request succeeded, false otherwise
-	Success bool `json:"success,omitempty"`
+		if res.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(apiErrorDiagnostics("extend volume", res.StatusCode(), res.Body, req.Plan.Raw)...)
+			return
+		}
+
+		tflog.Debug(ctx, "extended v2 volume", map[string]interface{}{
+			"volume_id": data.ID.ValueString(),
+			"new_size":  data.Size.ValueInt64(),
+		})
+	}
+
+	vol, err := r.waitVolumeSettled(ctx, data.ID.ValueString(), data.Size.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to wait for volume to settle, got error: %s", err),
+		)
+		return
+	}
+
+	data.Status = types.StringPointerValue(vol.Status)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -302,6 +403,15 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, volumeTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	res, err := r.providerData.client.DeleteV2Volume(ctx, &client.DeleteV2VolumeParams{
 		ProjectId: &r.providerData.projectID,
 	}, client.DeleteVolumeRequest{
@@ -316,17 +426,14 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 	defer func() { _ = res.Body.Close() }()
 
-	apiRes := ApiResponse{}
-	err = json.NewDecoder(res.Body).Decode(&apiRes)
+	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete v2 volume, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read delete volume response: %s", err))
 		return
 	}
 
 	if res.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to delete v2 volume, got error: %s", apiRes.Message),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("delete volume", res.StatusCode, body, req.State.Raw)...)
 		return
 	}
 
@@ -390,20 +497,16 @@ func (r *VolumeResource) findVolume(ctx context.Context, id string) (*VolumeResp
 		return nil, fmt.Errorf("error getting v2 volumes body: %w", err)
 	}
 
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get v2 volumes: %w", newAPIError(res.StatusCode, body))
+	}
+
 	apiRes := VolumeResponseApiResponse{}
 	err = json.NewDecoder(bytes.NewReader(body)).Decode(&apiRes)
 	if err != nil {
 		return nil, fmt.Errorf("decode volume api response: %w", err)
 	}
 
-	if res.StatusCode != http.StatusOK {
-		msg := "unknown error"
-		if apiRes.Message != nil {
-			msg = *apiRes.Message
-		}
-		return nil, fmt.Errorf("get v2 volumes returned status code %d: %s", res.StatusCode, msg)
-	}
-
 	var vol *VolumeResponse
 	for _, _vol := range apiRes.Result {
 		if _vol.VolumeId.String() != id {
@@ -420,6 +523,55 @@ func (r *VolumeResource) findVolume(ctx context.Context, id string) (*VolumeResp
 	return vol, nil
 }
 
+// volumeSettledStatuses are the statuses of a volume that no operation is in
+// progress on. Any other status, including none, is transitional.
+var volumeSettledStatuses = map[string]bool{
+	"available": true,
+	"in-use":    true,
+}
+
+// volumeFailedStatuses are the statuses of a volume whose last operation
+// failed.
+var volumeFailedStatuses = map[string]bool{
+	"error":           true,
+	"error_extending": true,
+}
+
+// waitVolumeSettled waits until the volume has reached the given size and is
+// in one of volumeSettledStatuses.
+func (r *VolumeResource) waitVolumeSettled(ctx context.Context, id string, size int64) (*VolumeResponse, error) {
+	for {
+		vol, err := r.findVolume(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		status := ""
+		if vol.Status != nil {
+			status = strings.ToLower(*vol.Status)
+		}
+
+		if volumeFailedStatuses[status] {
+			return nil, fmt.Errorf("volume %s failed with status %q", id, *vol.Status)
+		}
+
+		if vol.Size != nil && *vol.Size >= size && volumeSettledStatuses[status] {
+			return vol, nil
+		}
+
+		tflog.Debug(ctx, "waiting for volume to settle", map[string]interface{}{
+			"volume_id": id,
+			"status":    status,
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout waiting for volume to settle: %w", ctx.Err())
+		case <-time.After(3 * time.Second):
+		}
+	}
+}
+
 func (r *VolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccVolumeResource(t *testing.T) {
@@ -22,7 +30,7 @@ func TestAccVolumeResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccVolumeResourceConfig(),
+				Config: testAccVolumeResourceConfig(20),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("teraswitch_volume.test", "region_id", "PIT1"),
 					resource.TestCheckResourceAttr("teraswitch_volume.test", "display_name", "yeehaw"),
@@ -45,31 +53,85 @@ func TestAccVolumeResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccVolumeResourceConfig(),
+				Config: testAccVolumeResourceConfig(30),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("teraswitch_volume.test", "region_id", "PIT1"),
 					resource.TestCheckResourceAttr("teraswitch_volume.test", "display_name", "yeehaw"),
-					resource.TestCheckResourceAttr("teraswitch_volume.test", "size", "20"),
+					resource.TestCheckResourceAttr("teraswitch_volume.test", "size", "30"),
 					resource.TestCheckResourceAttr("teraswitch_volume.test", "volume_type", "nvme"),
 					resource.TestCheckResourceAttr("teraswitch_volume.test", "description", "test 111"),
 					resource.TestCheckResourceAttrSet("teraswitch_volume.test", "status"),
 				),
 			},
+			// Shrinking is rejected at plan time
+			{
+				Config:      testAccVolumeResourceConfig(20),
+				ExpectError: regexp.MustCompile("Volume Size Cannot Be Decreased"),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
-func testAccVolumeResourceConfig() string {
-	return `
+func testAccVolumeResourceConfig(size int) string {
+	return fmt.Sprintf(`
 provider "teraswitch" {}
 
 resource "teraswitch_volume" "test" {
 	region_id      = "PIT1"
 	display_name   = "yeehaw"
-	size           = 20
+	size           = %d
 	volume_type    = "nvme"
 	description    = "test 111"
 }
-`
+`, size)
+}
+
+func TestVolumeResourceModifyPlan_shrink(t *testing.T) {
+	ctx := context.Background()
+
+	r := &VolumeResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	volume := func(regionID string, size int64) tftypes.Value {
+		state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+		diags := state.Set(ctx, &VolumeResourceModel{
+			ID:          types.StringValue("vol-1"),
+			RegionID:    types.StringValue(regionID),
+			DisplayName: types.StringValue("data"),
+			VolumeType:  types.StringValue("NVME"),
+			Size:        types.Int64Value(size),
+			Description: types.StringNull(),
+			ImageName:   types.StringNull(),
+			Status:      types.StringValue("available"),
+			Timeouts:    nullTimeouts(),
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+		return state.Raw
+	}
+
+	tests := []struct {
+		name      string
+		plan      tftypes.Value
+		wantError bool
+	}{
+		{"extended", volume("PIT1", 200), false},
+		{"shrunk", volume("PIT1", 50), true},
+		{"shrunk while replaced", volume("LAX1", 50), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: volume("PIT1", 100)},
+				Plan:  tfsdk.Plan{Schema: s, Raw: tt.plan},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			require.Equal(t, tt.wantError, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
 }